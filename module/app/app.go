@@ -91,6 +91,7 @@ import (
 
 	gravityparams "github.com/cosmos/gravity-bridge/module/app/params"
 	"github.com/cosmos/gravity-bridge/module/x/gravity"
+	gravityclient "github.com/cosmos/gravity-bridge/module/x/gravity/client"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
@@ -117,6 +118,7 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gravityclient.CancelQueuedDepositProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -328,6 +330,17 @@ func NewGravityApp(
 		homePath,
 	)
 
+	// the gravity keeper has to exist before its hooks are registered, it gets a pointer to the
+	// staking keeper so that its slashing runs the hooks below
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		&stakingKeeper,
+		app.bankKeeper,
+		app.slashingKeeper,
+	)
+
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
@@ -336,6 +349,10 @@ func NewGravityApp(
 		),
 	)
 
+	app.upgradeKeeper.SetUpgradeHandler(GravityUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		app.gravityKeeper.MigrateStore(ctx)
+	})
+
 	app.ibcKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibchost.StoreKey],
@@ -349,7 +366,8 @@ func NewGravityApp(
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(gravitytypes.RouterKey, gravity.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -380,15 +398,6 @@ func NewGravityApp(
 	)
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		stakingKeeper,
-		app.bankKeeper,
-		app.slashingKeeper,
-	)
-
 	var skipGenesisInvariants = cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 
 	app.mm = module.NewManager(
@@ -487,10 +496,12 @@ func NewGravityApp(
 		minttypes.ModuleName,
 		crisistypes.ModuleName,
 		ibchost.ModuleName,
+		// the gentxs set the delegate keys with a signature over the gravity id, which has to be
+		// known by then
+		gravitytypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
-		gravitytypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -552,6 +563,10 @@ func NewGravityApp(
 	return app
 }
 
+// GravityUpgradeName is the name of the software upgrade plan that migrates the gravity store
+// of a chain running the previous version of the module
+const GravityUpgradeName = "gravity-v2"
+
 // MakeCodecs constructs the *std.Codec and *codec.LegacyAmino instances used by
 // simapp. It is useful for tests and clients who do not want to construct the
 // full simapp
@@ -13,6 +13,7 @@ import (
 	"sort"
 	"strings"
 
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	cfg "github.com/tendermint/tendermint/config"
@@ -27,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
@@ -34,6 +36,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gravitycli "github.com/cosmos/gravity-bridge/module/x/gravity/client/cli"
 	gravitytypes "github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
 
@@ -43,17 +46,19 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 	fsCreateValidator, defaultsDesc := cli.CreateValidatorMsgFlagSet(ipDefault)
 
 	cmd := &cobra.Command{
-		Use:   "gentx [key_name] [amount] [eth-address] [orchestrator-address]",
+		Use:   "gentx [key_name] [amount] [orchestrator-key-name]",
 		Short: "Generate a genesis tx carrying a self delegation, oracle key delegation and orchestrator key delegation",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(3),
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
-delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
-ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. The 
+Ethereum key signs the delegation to prove that it is held by the validator, it is read from --eth-key-file or prompted 
+for. The orchestrator key, which has to be in the Keyring as well, co-signs the transaction. A node ID and Bech32 
+consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the priv_validator.json 
+file. The following default parameters are included:
     %s
 
 Example:
-$ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn --home=/path/to/home/dir --keyring-backend=os --chain-id=test-chain-1 \
+$ %s gentx my-key-name 1000000stake my-orchestrator-key-name --eth-key-file=/path/to/eth/keystore --home=/path/to/home/dir --keyring-backend=os --chain-id=test-chain-1 \
     --moniker="myvalidator" \
     --commission-max-change-rate=0.01 \
     --commission-max-rate=1.0 \
@@ -114,16 +119,17 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrapf(err, "failed to fetch '%s' from the keyring", name)
 			}
 
-			ethAddress := args[2]
-
-			if err := gravitytypes.ValidateEthAddress(ethAddress); err != nil {
-				return errors.Wrapf(err, "invalid ethereum address")
+			ethPrivateKey, err := gravitycli.ReadEthPrivateKey(cmd, inBuf)
+			if err != nil {
+				return errors.Wrap(err, "invalid ethereum private key")
 			}
 
-			orchAddress, err := sdk.AccAddressFromBech32(args[3])
+			orchName := args[2]
+			orchKey, err := clientCtx.Keyring.Key(orchName)
 			if err != nil {
-				return errors.Wrapf(err, "failed to parse orchAddress(%s)", args[3])
+				return errors.Wrapf(err, "failed to fetch '%s' from the keyring", orchName)
 			}
+			orchAddress := orchKey.GetAddress()
 
 			moniker := config.Moniker
 			if m, _ := cmd.Flags().GetString(cli.FlagMoniker); m != "" {
@@ -179,14 +185,34 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
-			delegateGravityMsg := &gravitytypes.MsgSetOrchestratorAddress{
-				Validator:    sdk.ValAddress(key.GetAddress()).String(),
-				Orchestrator: orchAddress.String(),
-				EthAddress:   ethAddress,
+			// the delegate keys of a validator are set for the first time, so the nonce is 0
+			var gravityGenesis gravitytypes.GenesisState
+			if err := cdc.UnmarshalJSON(genesisState[gravitytypes.ModuleName], &gravityGenesis); err != nil || gravityGenesis.Params == nil {
+				return errors.New("failed to read the gravity id from the genesis file")
 			}
+			valAddress := sdk.ValAddress(key.GetAddress())
+			delegateKeysHash := gravitytypes.GetDelegateKeysHash(gravityGenesis.Params.GravityId, valAddress, orchAddress, 0)
+			ethSignature, err := gravitytypes.NewEthereumSignature(delegateKeysHash, ethPrivateKey)
+			if err != nil {
+				return errors.Wrap(err, "failed to sign delegate keys")
+			}
+			delegateGravityMsg := gravitytypes.NewMsgSetOrchestratorAddress(
+				valAddress,
+				orchAddress,
+				ethcrypto.PubkeyToAddress(ethPrivateKey.PublicKey).Hex(),
+				0,
+				ethSignature,
+			)
 
 			msgs := []sdk.Msg{msg, delegateGravityMsg}
 
+			// the orchestrator co-signs, the signer infos are part of the bytes signed in direct
+			// mode, so a second signature would invalidate the first one
+			coSigned := !orchAddress.Equals(key.GetAddress())
+			if coSigned {
+				txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			}
+
 			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
 				return authclient.PrintUnsignedStdTx(txBldr, clientCtx, msgs)
@@ -216,6 +242,12 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 			if err != nil {
 				return errors.Wrap(err, "failed to sign std tx")
 			}
+			if coSigned {
+				err = authclient.SignTx(txFactory, clientCtx, orchName, txBuilder, true, false)
+				if err != nil {
+					return errors.Wrap(err, "failed to co-sign std tx with the orchestrator key")
+				}
+			}
 
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 			if outputDocument == "" {
@@ -238,6 +270,7 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
+	gravitycli.AddEthKeyFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
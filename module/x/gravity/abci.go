@@ -1,8 +1,6 @@
 package gravity
 
 import (
-	"sort"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
@@ -14,25 +12,51 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Question: what here can be epoched?
 	slashing(ctx, k)
 	attestationTally(ctx, k)
+	k.ReleaseQueuedDeposits(ctx)
 	cleanupTimedOutBatches(ctx, k)
+	k.AutoBuildOutgoingTXBatches(ctx)
 	cleanupTimedOutLogicCalls(ctx, k)
-	createValsets(ctx, k)
+	createValsets(ctx, k, params)
 	pruneValsets(ctx, k, params)
-	// TODO: prune claims, attestations when they pass in the handler
+	k.PruneObservedValsets(ctx, params.ObservedValsetsWindow)
+	k.CompleteTimedOutEthKeyRotations(ctx, params.EthKeyRotationTimeout)
+	pruneAttestations(ctx, k)
 }
 
-func createValsets(ctx sdk.Context, k keeper.Keeper) {
+func createValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// Auto ValsetRequest Creation.
 	// WARNING: do not use k.GetLastObservedValset in this function, it *will* result in losing control of the bridge
 	// 1. If there are no valset requests, create a new one.
-	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
-	//      This will make sure the unbonding validator has to provide an attestation to a new Valset
-	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
+	// 2. Otherwise wait until ValsetMinSpacing blocks have passed since the latest valset, then create one
+	//    a. If there is at least one validator who started unbonding since the latest valset. (we persist last unbonded block height in hooks.go)
+	//       This will make sure the unbonding validator has to provide an attestation to a new Valset
+	//       that excludes him before he completely Unbonds.  Otherwise he will be slashed
+	//    b. If a validator was bonded, removed or slashed since the latest valset (we persist the request in hooks.go)
+	//    c. If power change between validators of CurrentValset and latest valset request is > ValsetPowerChangeThreshold
+	//    d. If the latest valset is at least ValsetMaxAge blocks old, so that signer keys don't go stale
 	latestValset := k.GetLatestValset(ctx)
-	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
+	if latestValset == nil {
+		k.SetValsetRequest(ctx)
+		return
+	}
+
+	// the spacing has to stay below the unbond slashing window so that an unbonding validator
+	// always gets a valset to sign in time, a governance param change skips Params.ValidateBasic
+	// so this is enforced here as well
+	minSpacing := params.ValsetMinSpacing
+	if params.UnbondSlashingValsetsWindow > 0 && minSpacing >= params.UnbondSlashingValsetsWindow {
+		minSpacing = params.UnbondSlashingValsetsWindow - 1
+	}
+	currentHeight := uint64(ctx.BlockHeight())
+	if currentHeight < latestValset.Height+minSpacing {
+		return
+	}
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || (types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiff(latestValset.Members) > 0.05) {
+	unbonding := k.GetLastUnBondingBlockHeight(ctx) > latestValset.Height
+	requested := k.GetValsetUpdateRequestHeight(ctx) > latestValset.Height
+	powerChanged := types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiffDec(latestValset.Members).GT(params.ValsetPowerChangeThreshold)
+	tooOld := params.ValsetMaxAge != 0 && currentHeight >= latestValset.Height+params.ValsetMaxAge
+	if unbonding || requested || powerChanged || tooOld {
 		// Store valset
 		k.SetValsetRequest(ctx)
 	}
@@ -59,6 +83,35 @@ func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
+func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
+	// Attestation pruning
+	// prune all attestations with an event nonce up to the last slashed
+	// attestation nonce, ClaimsSlashing only moves past an event nonce once
+	// it has been observed and the signed claims window has passed, so
+	// slashing always occurs before we remove them
+	lastSlashed := k.GetLastSlashedAttestationNonce(ctx)
+	var stale []types.Attestation
+	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic("couldn't cast to claim")
+		}
+		// attestations are iterated in order of event nonce
+		if claim.GetEventNonce() > lastSlashed {
+			return true
+		}
+		stale = append(stale, att)
+		return false
+	})
+	for i := range stale {
+		claim, err := k.UnpackAttestationClaim(&stale[i])
+		if err != nil {
+			panic("couldn't cast to claim")
+		}
+		k.DeleteAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), &stale[i])
+	}
+}
+
 func slashing(ctx sdk.Context, k keeper.Keeper) {
 
 	params := k.GetParams(ctx)
@@ -66,52 +119,15 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	// Slash validator for not confirming valset requests, batch requests
 	ValsetSlashing(ctx, k, params)
 	BatchSlashing(ctx, k, params)
-	// TODO slashing for arbitrary logic signatures is missing
+	LogicCallSlashing(ctx, k, params)
+	ClaimsSlashing(ctx, k, params)
 
 }
 
-// Iterate over all attestations currently being voted on in order of nonce and
-// "Observe" those who have passed the threshold. Break the loop once we see
-// an attestation that has not passed the threshold
+// Count the votes on the attestations at the next event nonce to be observed and
+// "Observe" those who have passed the threshold, see keeper.TallyAttestations
 func attestationTally(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetAttestationMapping(ctx)
-	// We make a slice with all the event nonces that are in the attestation mapping
-	nonces := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		nonces = append(nonces, k)
-	}
-	// Then we sort it
-	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range nonces {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryAttestation(ctx, &att)
-			}
-		}
-	}
+	k.TallyAttestations(ctx)
 }
 
 // cleanupTimedOutBatches deletes batches that have passed their expiration on Ethereum
@@ -166,8 +182,6 @@ func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// unslashedValsets are sorted by nonce in ASC order
 	// Question: do we need to sort each time? See if this can be epoched
 	for _, vs := range unslashedValsets {
-		confirms := k.GetValsetConfirms(ctx, vs.Nonce)
-
 		// SLASH BONDED VALIDTORS who didn't attest valset request
 		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 		for _, val := range currentBondedSet {
@@ -175,17 +189,10 @@ func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
 
 			//  Slash validator ONLY if he joined before valset is created
-			if exist && valSigningInfo.StartHeight < int64(vs.Nonce) {
-				// Check if validator has confirmed valset or not
-				found := false
-				for _, conf := range confirms {
-					if conf.EthAddress == k.GetEthAddressByValidator(ctx, val.GetOperator()) {
-						found = true
-						break
-					}
-				}
+			if exist && valSigningInfo.StartHeight < int64(vs.Height) {
+				// Check if validator has confirmed valset or not, with any of its orchestrator keys
 				// slash validators for not confirming valsets
-				if !found {
+				if k.GetValsetConfirm(ctx, vs.Nonce, val.GetOperator()) == nil {
 					cons, _ := val.GetConsAddr()
 					k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionValset)
 					if !val.IsJailed() {
@@ -216,18 +223,10 @@ func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 				valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
 
 				// Only slash validators who joined after valset is created and they are unbonding and UNBOND_SLASHING_WINDOW didn't passed
-				if exist && valSigningInfo.StartHeight < int64(vs.Nonce) && validator.IsUnbonding() && vs.Nonce < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow {
-					// Check if validator has confirmed valset or not
-					found := false
-					for _, conf := range confirms {
-						if conf.EthAddress == k.GetEthAddressByValidator(ctx, validator.GetOperator()) {
-							found = true
-							break
-						}
-					}
-
+				if exist && valSigningInfo.StartHeight < int64(vs.Height) && validator.IsUnbonding() && vs.Height < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow {
+					// Check if validator has confirmed valset or not, with any of its orchestrator keys
 					// slash validators for not confirming valsets
-					if !found {
+					if k.GetValsetConfirm(ctx, vs.Nonce, validator.GetOperator()) == nil {
 						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(), params.SlashFractionValset)
 						if !validator.IsJailed() {
 							k.StakingKeeper.Jail(ctx, valConsAddr)
@@ -258,7 +257,6 @@ func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
 		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-		confirms := k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)
 		for _, val := range currentBondedSet {
 			// Don't slash validators who joined after batch is created
 			consAddr, _ := val.GetConsAddr()
@@ -267,16 +265,8 @@ func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 				continue
 			}
 
-			found := false
-			for _, conf := range confirms {
-				// TODO: double check this logic
-				confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-				if k.GetOrchestratorValidator(ctx, confVal).Equals(val.GetOperator()) {
-					found = true
-					break
-				}
-			}
-			if !found {
+			// confirms are stored by validator, whichever of its orchestrator keys sent them
+			if k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, val.GetOperator()) == nil {
 				cons, _ := val.GetConsAddr()
 				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionBatch)
 				if !val.IsJailed() {
@@ -290,6 +280,150 @@ func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
+func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+
+	// #3 condition
+	// We look through the full bonded set and we slash users who haven't signed
+	// a logic call confirmation that is SignedLogicCallsWindow blocks old
+	maxHeight := uint64(0)
+
+	// don't slash in the beginning before there aren't even SignedLogicCallsWindow blocks yet
+	if uint64(ctx.BlockHeight()) > params.SignedLogicCallsWindow {
+		maxHeight = uint64(ctx.BlockHeight()) - params.SignedLogicCallsWindow
+	}
+
+	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight)
+	for _, call := range unslashedLogicCalls {
+
+		// SLASH BONDED VALIDTORS who didn't attest logic calls
+		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+		for _, val := range currentBondedSet {
+			// Don't slash validators who joined after the logic call is created
+			consAddr, _ := val.GetConsAddr()
+			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			if exist && valSigningInfo.StartHeight > int64(call.Block) {
+				continue
+			}
+
+			// confirms are stored by validator, whichever of its orchestrator keys sent them
+			if k.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, val.GetOperator()) == nil {
+				k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionLogicCall)
+				if !val.IsJailed() {
+					k.StakingKeeper.Jail(ctx, consAddr)
+				}
+			}
+		}
+		// then we set the latest slashed logic call block
+		k.SetLastSlashedLogicCallBlock(ctx, call.Block)
+	}
+}
+
+// claimsSlashingNoncesPerBlock is the maximum number of event nonces ClaimsSlashing walks in a block
+const claimsSlashingNoncesPerBlock = 100
+
+func ClaimsSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+
+	// #4 condition
+	// We look through every observed attestation that is older than SignedClaimsWindow
+	// and slash the bonded validators that did not submit a claim for it, as well as
+	// the validators that voted for a conflicting claim at the same event nonce
+	maxHeight := uint64(0)
+
+	// don't slash in the beginning before there aren't even SignedClaimsWindow blocks yet
+	if uint64(ctx.BlockHeight()) > params.SignedClaimsWindow {
+		maxHeight = uint64(ctx.BlockHeight()) - params.SignedClaimsWindow
+	}
+
+	// attestations are only ever observed in order of event nonce, so we walk them the same way
+	// and stop at the first one that is not observed yet or is still inside the window. A backlog
+	// of nonces is worked off over several blocks instead of in one unbounded loop
+	first := k.GetLastSlashedAttestationNonce(ctx) + 1
+	last := k.GetLastObservedEventNonce(ctx)
+	if last >= first+claimsSlashingNoncesPerBlock {
+		last = first + claimsSlashingNoncesPerBlock - 1
+	}
+	for nonce := first; nonce <= last; nonce++ {
+		att := k.GetObservedAttestation(ctx, nonce)
+		if att != nil {
+			if att.Height >= maxHeight {
+				return
+			}
+			observedClaim, err := k.UnpackAttestationClaim(att)
+			if err != nil {
+				panic("couldn't cast to claim")
+			}
+
+			// SLASH VALIDATORS who voted for a claim other than the observed one, a validator
+			// is slashed only once per event nonce no matter how many conflicting claims they voted for
+			conflicting := make(map[string]bool)
+			k.IterateAttestationsByEventNonce(ctx, nonce, func(_ []byte, other types.Attestation) bool {
+				if other.Observed {
+					return false
+				}
+				otherClaim, err := k.UnpackAttestationClaim(&other)
+				if err != nil {
+					panic("couldn't cast to claim")
+				}
+				for _, vote := range other.Votes {
+					valAddr, err := sdk.ValAddressFromBech32(vote)
+					if err != nil {
+						panic(err)
+					}
+					if conflicting[vote] {
+						continue
+					}
+					conflicting[vote] = true
+					k.EmitConflictingClaimEvent(ctx, valAddr, nonce, observedClaim.ClaimHash(), otherClaim.ClaimHash())
+
+					// staking panics when slashing an unbonded validator
+					val, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+					if !found || val.IsUnbonded() {
+						continue
+					}
+					consAddr, _ := val.GetConsAddr()
+					k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionConflictingClaim)
+				}
+				return false
+			})
+
+			voted := make(map[string]bool, len(att.Votes))
+			for _, vote := range att.Votes {
+				voted[vote] = true
+			}
+
+			// SLASH BONDED VALIDTORS who didn't submit a claim for the observed event
+			currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+			for _, val := range currentBondedSet {
+				// Don't slash validators who joined after the attestation is created
+				consAddr, _ := val.GetConsAddr()
+				valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+				if exist && valSigningInfo.StartHeight > int64(att.Height) {
+					continue
+				}
+
+				// validators who voted for a conflicting claim were already slashed above
+				operator := val.GetOperator().String()
+				if !voted[operator] && !conflicting[operator] {
+					// missed claims are slashed at most once per SignedClaimsWindow, no matter how
+					// many events the validator missed in it
+					lastSlashed := k.GetLastClaimsSlashingHeight(ctx, val.GetOperator())
+					if lastSlashed != 0 && uint64(ctx.BlockHeight()) < lastSlashed+params.SignedClaimsWindow {
+						continue
+					}
+					k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionClaim)
+					if !val.IsJailed() {
+						k.StakingKeeper.Jail(ctx, consAddr)
+					}
+					k.SetLastClaimsSlashingHeight(ctx, val.GetOperator())
+				}
+			}
+		}
+		// then we set the latest slashed attestation nonce, an observed nonce without
+		// an attestation in the store (e.g. after a genesis import) is simply skipped
+		k.SetLastSlashedAttestationNonce(ctx, nonce)
+	}
+}
+
 // TestingEndBlocker is a second endblocker function only imported in the Gravity codebase itself
 // if you are a consuming Cosmos chain DO NOT IMPORT THIS, it simulates a chain using the arbitrary
 // logic API to request logic calls
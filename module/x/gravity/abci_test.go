@@ -1,6 +1,8 @@
 package gravity
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
@@ -21,7 +24,7 @@ func TestValsetCreationIfNotAvailable(t *testing.T) {
 
 	// EndBlocker should set a new validator set if not available
 	EndBlocker(ctx, pk)
-	require.NotNil(t, pk.GetValset(ctx, uint64(ctx.BlockHeight())))
+	require.NotNil(t, pk.GetValset(ctx, 1))
 	valsets := pk.GetValsets(ctx)
 	require.True(t, len(valsets) == 1)
 }
@@ -41,7 +44,25 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 	EndBlocker(input.Context, pk)
 
-	assert.Equal(t, uint64(input.Context.BlockHeight()), pk.GetLatestValsetNonce(ctx))
+	assert.Equal(t, uint64(2), pk.GetLatestValsetNonce(ctx))
+}
+
+func TestValsetCreationUponSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	pk.SetValsetRequest(ctx)
+
+	// a slash too small to pass the ValsetPowerChangeThreshold still creates a valset
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.GetConsensusPower(), sdk.NewDecWithPrec(1, 2))
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	require.Less(t, types.BridgeValidators(pk.GetCurrentValset(ctx).Members).PowerDiff(pk.GetLatestValset(ctx).Members), 0.05)
+	EndBlocker(ctx, pk)
+
+	assert.Equal(t, uint64(2), pk.GetLatestValsetNonce(ctx))
 }
 
 func TestValsetSlashing_ValsetCreated_Before_ValidatorBonded(t *testing.T) {
@@ -84,7 +105,7 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 			continue
 		}
 		conf := types.NewMsgValsetConfirm(vs.Nonce, keeper.EthAddrs[i].String(), val, "dummysig")
-		pk.SetValsetConfirm(ctx, *conf)
+		pk.SetValsetConfirm(ctx, keeper.ValAddrs[i], *conf)
 	}
 
 	EndBlocker(ctx, pk)
@@ -144,7 +165,7 @@ func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T)
 			continue
 		}
 		conf := types.NewMsgValsetConfirm(vs.Nonce, keeper.EthAddrs[i].String(), val, "dummysig")
-		pk.SetValsetConfirm(ctx, *conf)
+		pk.SetValsetConfirm(ctx, keeper.ValAddrs[i], *conf)
 	}
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
@@ -192,7 +213,7 @@ func TestBatchSlashing(t *testing.T) {
 			input.SlashingKeeper.SetValidatorSigningInfo(ctx, valConsAddr, valSigningInfo)
 			continue
 		}
-		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		pk.SetBatchConfirm(ctx, keeper.ValAddrs[i], &types.MsgConfirmBatch{
 			Nonce:         batch.BatchNonce,
 			TokenContract: keeper.TokenContractAddrs[0],
 			EthSigner:     keeper.EthAddrs[i].String(),
@@ -216,13 +237,440 @@ func TestBatchSlashing(t *testing.T) {
 
 }
 
+func TestLogicCallSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	// First store a logic call
+	call := &types.OutgoingLogicCall{
+		Transfers:            []*types.ERC20Token{},
+		Fees:                 []*types.ERC20Token{},
+		LogicContractAddress: keeper.TokenContractAddrs[1],
+		Payload:              []byte("payload"),
+		Timeout:              10000,
+		InvalidationId:       []byte("invalidation"),
+		InvalidationNonce:    1,
+	}
+	require.NoError(t, pk.CreateOutgoingLogicCall(ctx, call))
+
+	// then move past its signing window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedLogicCallsWindow) + 2)
+
+	for i, val := range keeper.AccAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], val)
+		if i == 0 {
+			// don't sign with first validator
+			continue
+		}
+		if i == 1 {
+			// don't sign with 2nd validator. set val bond height > logic call block height
+			validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i])
+			valConsAddr, _ := validator.GetConsAddr()
+			valSigningInfo := slashingtypes.ValidatorSigningInfo{StartHeight: int64(call.Block + 1)}
+			input.SlashingKeeper.SetValidatorSigningInfo(ctx, valConsAddr, valSigningInfo)
+			continue
+		}
+		pk.SetLogicCallConfirm(ctx, keeper.ValAddrs[i], &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+			EthSigner:         keeper.EthAddrs[i].String(),
+			Orchestrator:      val.String(),
+			Signature:         "dummysig",
+		})
+	}
+
+	EndBlocker(ctx, pk)
+
+	// ensure that the validator is jailed and slashed
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.True(t, val.IsJailed())
+
+	// ensure that the validators who joined later or signed are not jailed and slashed
+	for _, valAddr := range keeper.ValAddrs[1:] {
+		val = input.StakingKeeper.Validator(ctx, valAddr)
+		require.False(t, val.IsJailed())
+	}
+
+	// Ensure that the last slashed logic call block is set properly
+	assert.Equal(t, call.Block, pk.GetLastSlashedLogicCallBlock(ctx))
+}
+
+func TestClaimsSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	h := NewHandler(pk)
+
+	for i, orch := range keeper.AccAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], orch)
+		if i == 0 {
+			// don't submit a claim with the first validator
+			continue
+		}
+		_, err := h(ctx, &types.MsgDepositClaim{
+			EventNonce:     1,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+			Orchestrator:   orch.String(),
+		})
+		require.NoError(t, err)
+	}
+	attestationTally(ctx, pk)
+	require.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+
+	// nothing is slashed while the attestation is still inside the window
+	ClaimsSlashing(ctx, pk, params)
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.False(t, val.IsJailed())
+	assert.Equal(t, uint64(0), pk.GetLastSlashedAttestationNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	ClaimsSlashing(ctx, pk, params)
+
+	// ensure that the validator who did not submit a claim is jailed and slashed
+	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.True(t, val.IsJailed())
+
+	// ensure that the validators who submitted a claim are not jailed and slashed
+	for _, valAddr := range keeper.ValAddrs[1:] {
+		val = input.StakingKeeper.Validator(ctx, valAddr)
+		require.False(t, val.IsJailed())
+	}
+
+	// Ensure that the last slashed attestation nonce is set properly
+	assert.Equal(t, uint64(1), pk.GetLastSlashedAttestationNonce(ctx))
+}
+
+func TestClaimsSlashingBacklog(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	h := NewHandler(pk)
+
+	// the first validator misses more events than are slashed in a block
+	const events = claimsSlashingNoncesPerBlock + 5
+	for i, orch := range keeper.AccAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], orch)
+	}
+	for nonce := uint64(1); nonce <= events; nonce++ {
+		for _, orch := range keeper.AccAddrs[1:] {
+			_, err := h(ctx, &types.MsgDepositClaim{
+				EventNonce:     nonce,
+				TokenContract:  keeper.TokenContractAddrs[0],
+				Amount:         sdk.NewInt(100),
+				EthereumSender: keeper.EthAddrs[0].String(),
+				CosmosReceiver: keeper.AccAddrs[0].String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+		attestationTally(ctx, pk)
+	}
+	require.Equal(t, uint64(events), pk.GetLastObservedEventNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	tokens := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	ClaimsSlashing(ctx, pk, params)
+	assert.Equal(t, uint64(claimsSlashingNoncesPerBlock), pk.GetLastSlashedAttestationNonce(ctx))
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.True(t, val.IsJailed())
+	slashed := val.GetTokens()
+	require.True(t, slashed.LT(tokens))
+
+	// the validator is slashed for missed claims only once per window
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Unjail(ctx, consAddr)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	ClaimsSlashing(ctx, pk, params)
+	assert.Equal(t, uint64(events), pk.GetLastSlashedAttestationNonce(ctx))
+	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	assert.False(t, val.IsJailed())
+	assert.Equal(t, slashed, val.GetTokens())
+}
+
+func TestConflictingClaimsSlashing_SplitVote(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.SlashFractionConflictingClaim = sdk.NewDecWithPrec(5, 2)
+	h := NewHandler(pk)
+
+	observedClaim := types.MsgDepositClaim{
+		EventNonce:     1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[0].String(),
+	}
+	conflictingClaim := observedClaim
+	conflictingClaim.CosmosReceiver = keeper.AccAddrs[1].String()
+
+	for i, orch := range keeper.AccAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], orch)
+		claim := observedClaim
+		if i == 0 {
+			// the first validator votes for a different claim at the same nonce
+			claim = conflictingClaim
+		}
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	attestationTally(ctx, pk)
+	require.True(t, pk.GetAttestation(ctx, 1, observedClaim.ClaimHash()).Observed)
+	require.False(t, pk.GetAttestation(ctx, 1, conflictingClaim.ClaimHash()).Observed)
+
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	ClaimsSlashing(ctx, pk, params)
+
+	// ensure that the validator who voted for the conflicting claim is slashed by the
+	// conflicting claim fraction only, and not jailed
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.False(t, val.IsJailed())
+	expectedTokens := tokensBefore.ToDec().Mul(sdk.OneDec().Sub(params.SlashFractionConflictingClaim)).TruncateInt()
+	assert.Equal(t, expectedTokens, val.GetTokens())
+
+	// ensure that the validators who voted for the observed claim are not jailed and slashed
+	for _, valAddr := range keeper.ValAddrs[1:] {
+		val = input.StakingKeeper.Validator(ctx, valAddr)
+		require.False(t, val.IsJailed())
+	}
+
+	// ensure the conflicting claim was reported
+	events := ctx.EventManager().Events()
+	var found bool
+	for _, event := range events {
+		if event.Type != types.EventTypeConflictingClaim {
+			continue
+		}
+		found = true
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		assert.Equal(t, keeper.ValAddrs[0].String(), attrs[types.AttributeKeyValidator])
+		assert.Equal(t, "1", attrs[types.AttributeKeyNonce])
+		assert.Equal(t, hex.EncodeToString(observedClaim.ClaimHash()), attrs[types.AttributeKeyObservedClaimHash])
+		assert.Equal(t, hex.EncodeToString(conflictingClaim.ClaimHash()), attrs[types.AttributeKeyConflictingClaimHash])
+	}
+	require.True(t, found)
+}
+
+func TestConflictingClaimsSlashing_UnbondedValidator(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	h := NewHandler(pk)
+
+	observedClaim := types.MsgDepositClaim{
+		EventNonce:     1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[0].String(),
+	}
+	for i, orch := range keeper.AccAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], orch)
+		claim := observedClaim
+		if i == 0 {
+			claim.CosmosReceiver = keeper.AccAddrs[1].String()
+		}
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	attestationTally(ctx, pk)
+
+	// the validator who voted for the conflicting claim left the validator set since
+	val, found := input.StakingKeeper.GetValidator(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	val.Status = stakingtypes.Unbonded
+	input.StakingKeeper.SetValidator(ctx, val)
+	tokensBefore := val.GetTokens()
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	require.NotPanics(t, func() { ClaimsSlashing(ctx, pk, params) })
+	assert.Equal(t, tokensBefore, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens())
+	assert.Equal(t, uint64(1), pk.GetLastSlashedAttestationNonce(ctx))
+}
+
+func TestConflictingClaimsSlashing_DoubleVote(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	h := NewHandler(pk)
+
+	observedClaim := types.MsgDepositClaim{
+		EventNonce:     1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[0].String(),
+	}
+	conflictingClaim := observedClaim
+	conflictingClaim.CosmosReceiver = keeper.AccAddrs[1].String()
+
+	for i, orch := range keeper.AccAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], orch)
+		claim := observedClaim
+		if i == 0 {
+			claim = conflictingClaim
+		}
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+
+	// the first validator tries to vote again at the same nonce, for the observed claim and
+	// for another conflicting claim through a second orchestrator key. The event nonce is
+	// tracked per validator so both votes are rejected
+	secondOrch := sdk.AccAddress(bytes.Repeat([]byte{0x9}, sdk.AddrLen))
+	pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[0], secondOrch)
+	otherConflictingClaim := observedClaim
+	otherConflictingClaim.CosmosReceiver = keeper.AccAddrs[2].String()
+	for _, vote := range []struct {
+		claim types.MsgDepositClaim
+		orch  sdk.AccAddress
+	}{
+		{observedClaim, keeper.AccAddrs[0]},
+		{otherConflictingClaim, secondOrch},
+	} {
+		claim := vote.claim
+		claim.Orchestrator = vote.orch.String()
+		_, err := h(ctx, &claim)
+		require.True(t, types.ErrNonContiguousEventNonce.Is(err), err)
+	}
+	require.Nil(t, pk.GetAttestation(ctx, 1, otherConflictingClaim.ClaimHash()))
+
+	attestationTally(ctx, pk)
+	require.True(t, pk.GetAttestation(ctx, 1, observedClaim.ClaimHash()).Observed)
+
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	ClaimsSlashing(ctx, pk, params)
+
+	// ensure that the validator is slashed once for its single conflicting vote
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.False(t, val.IsJailed())
+	expectedTokens := tokensBefore.ToDec().Mul(sdk.OneDec().Sub(params.SlashFractionConflictingClaim)).TruncateInt()
+	assert.Equal(t, expectedTokens, val.GetTokens())
+
+	for _, valAddr := range keeper.ValAddrs[1:] {
+		val = input.StakingKeeper.Validator(ctx, valAddr)
+		require.False(t, val.IsJailed())
+	}
+}
+
+func TestAttestationVotesPowerThreshold(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+
+	claim := types.MsgDepositClaim{
+		EventNonce:     1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[0].String(),
+	}
+	// three out of five equally powered validators vote, 60% of the power
+	for i, orch := range keeper.AccAddrs[:3] {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], orch)
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+
+	attestationTally(ctx, pk)
+	require.False(t, pk.GetAttestation(ctx, 1, claim.ClaimHash()).Observed)
+	require.Equal(t, uint64(0), pk.GetLastObservedEventNonce(ctx))
+
+	// lowering the threshold applies to the attestation that is still pending
+	params := pk.GetParams(ctx)
+	params.AttestationVotesPowerThreshold = sdk.NewDecWithPrec(6, 1)
+	pk.SetParams(ctx, params)
+
+	attestationTally(ctx, pk)
+	require.True(t, pk.GetAttestation(ctx, 1, claim.ClaimHash()).Observed)
+	require.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+}
+
+func TestAttestationPruning(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	h := NewHandler(pk)
+
+	for i, orch := range keeper.AccAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], orch)
+	}
+	countAttestations := func() (count uint64) {
+		pk.IterateAttestaions(ctx, func(_ []byte, _ types.Attestation) bool {
+			count++
+			return false
+		})
+		return
+	}
+
+	// one event per block, every validator submits a claim for it
+	const events = 3000
+	for nonce := uint64(1); nonce <= events; nonce++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		for _, orch := range keeper.AccAddrs {
+			_, err := h(ctx, &types.MsgDepositClaim{
+				EventNonce:     nonce,
+				TokenContract:  keeper.TokenContractAddrs[0],
+				Amount:         sdk.NewInt(1),
+				EthereumSender: keeper.EthAddrs[0].String(),
+				CosmosReceiver: keeper.AccAddrs[0].String(),
+				Orchestrator:   orch.String(),
+			})
+			require.NoError(t, err)
+		}
+		attestationTally(ctx, pk)
+		ClaimsSlashing(ctx, pk, params)
+		pruneAttestations(ctx, pk)
+
+		// only the attestations inside the signed claims window are kept around
+		require.LessOrEqual(t, countAttestations(), params.SignedClaimsWindow+1)
+	}
+	require.Equal(t, uint64(events), pk.GetLastObservedEventNonce(ctx))
+	lastSlashed := pk.GetLastSlashedAttestationNonce(ctx)
+	require.Equal(t, uint64(events)-params.SignedClaimsWindow-1, lastSlashed)
+	require.Nil(t, pk.GetAttestation(ctx, 1, (&types.MsgDepositClaim{
+		TokenContract:  keeper.TokenContractAddrs[0],
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[0].String(),
+	}).ClaimHash()))
+
+	// nobody missed a claim
+	for _, valAddr := range keeper.ValAddrs {
+		require.False(t, input.StakingKeeper.Validator(ctx, valAddr).IsJailed())
+	}
+
+	// claims for pruned events are rejected
+	_, err := h(ctx, &types.MsgDepositClaim{
+		EventNonce:     lastSlashed,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(1),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[1].String(),
+		Orchestrator:   keeper.AccAddrs[0].String(),
+	})
+	require.Error(t, err)
+}
+
 func TestValsetEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
 
 	// Store a validator set with a power change as the most recent validator set
 	vs := pk.GetCurrentValset(ctx)
-	vs.Nonce--
 	delta := float64(types.BridgeValidators(vs.Members).TotalPower()) * 0.05
 	vs.Members[0].Power = uint64(float64(vs.Members[0].Power) - delta/2)
 	vs.Members[1].Power = uint64(float64(vs.Members[1].Power) + delta/2)
@@ -230,11 +678,76 @@ func TestValsetEmission(t *testing.T) {
 
 	// EndBlocker should set a new validator set
 	EndBlocker(ctx, pk)
-	require.NotNil(t, pk.GetValset(ctx, uint64(ctx.BlockHeight())))
+	require.NotNil(t, pk.GetValset(ctx, vs.Nonce+1))
 	valsets := pk.GetValsets(ctx)
 	require.True(t, len(valsets) == 2)
 }
 
+func TestValsetTriggerPolicy(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.ValsetMinSpacing = 5
+	params.ValsetMaxAge = 20
+	// keep unsigned valsets from jailing validators and changing the power
+	params.SignedValsetsWindow = 100
+	pk.SetParams(ctx, params)
+
+	// the first valset is created right away
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(1), pk.GetLatestValsetNonce(ctx))
+	start := ctx.BlockHeight()
+	atHeight := func(offset int64) sdk.Context {
+		return ctx.WithBlockHeight(start + offset)
+	}
+
+	// a power change waits for the min spacing
+	vs := pk.GetLatestValset(ctx)
+	delta := float64(types.BridgeValidators(vs.Members).TotalPower()) * 0.05
+	vs.Members[0].Power = uint64(float64(vs.Members[0].Power) - delta/2)
+	vs.Members[1].Power = uint64(float64(vs.Members[1].Power) + delta/2)
+	pk.StoreValsetUnsafe(ctx, vs)
+	EndBlocker(atHeight(4), pk)
+	require.Equal(t, uint64(1), pk.GetLatestValsetNonce(ctx))
+	EndBlocker(atHeight(5), pk)
+	require.Equal(t, uint64(2), pk.GetLatestValsetNonce(ctx))
+
+	// without changes a new valset is created once the latest one reaches the max age
+	EndBlocker(atHeight(24), pk)
+	require.Equal(t, uint64(2), pk.GetLatestValsetNonce(ctx))
+	EndBlocker(atHeight(25), pk)
+	require.Equal(t, uint64(3), pk.GetLatestValsetNonce(ctx))
+
+	// an unbonding validator triggers a valset once the spacing has passed
+	pk.SetLastUnBondingBlockHeight(ctx, uint64(start+26))
+	EndBlocker(atHeight(29), pk)
+	require.Equal(t, uint64(3), pk.GetLatestValsetNonce(ctx))
+	EndBlocker(atHeight(30), pk)
+	require.Equal(t, uint64(4), pk.GetLatestValsetNonce(ctx))
+}
+
+func TestValsetMinSpacingBelowUnbondSlashingWindow(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	// a param change proposal sets each param on its own, without Params.ValidateBasic
+	params := pk.GetParams(ctx)
+	params.ValsetMinSpacing = 50
+	params.UnbondSlashingValsetsWindow = 10
+	params.SignedValsetsWindow = 100
+	pk.SetParams(ctx, params)
+
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(1), pk.GetLatestValsetNonce(ctx))
+	start := ctx.BlockHeight()
+
+	// an unbonding validator still gets a valset within the unbond slashing window
+	pk.SetLastUnBondingBlockHeight(ctx, uint64(start+1))
+	EndBlocker(ctx.WithBlockHeight(start+8), pk)
+	require.Equal(t, uint64(1), pk.GetLatestValsetNonce(ctx))
+	EndBlocker(ctx.WithBlockHeight(start+9), pk)
+	require.Equal(t, uint64(2), pk.GetLatestValsetNonce(ctx))
+}
+
 func TestValsetSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -268,12 +781,15 @@ func TestBatchTimeout(t *testing.T) {
 	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
 
 	// add some TX to the pool
-	for i, v := range []uint64{2, 3, 2, 1, 5, 6} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
-		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
-		require.NoError(t, err)
+	addToPool := func(fees ...uint64) {
+		for i, v := range fees {
+			amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
+			fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+			_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+			require.NoError(t, err)
+		}
 	}
+	addToPool(2, 3, 2, 1, 5, 6)
 
 	// when
 	ctx = ctx.WithBlockTime(now)
@@ -286,6 +802,8 @@ func TestBatchTimeout(t *testing.T) {
 
 	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
 
+	// every new batch has to be more profitable than the last one
+	addToPool(7, 8)
 	b2, err2 := pk.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
 	require.NoError(t, err2)
 	// this is exactly block 500 plus twelve hours
@@ -301,6 +819,7 @@ func TestBatchTimeout(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 	ctx = ctx.WithBlockHeight(9)
 
+	addToPool(9, 10)
 	b3, err2 := pk.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
 	require.NoError(t, err2)
 
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+)
+
+// FlagEthKeyFile is the file holding the Ethereum key that signs a delegation or a key rotation
+const FlagEthKeyFile = "eth-key-file"
+
+// AddEthKeyFlag adds the flag to read the Ethereum private key from a file
+func AddEthKeyFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagEthKeyFile, "", "Ethereum keystore file, or file holding the hex encoded private key. Prompts for the key if not set")
+}
+
+// ReadEthPrivateKey reads the Ethereum private key from the file passed with --eth-key-file. An
+// encrypted keystore file is unlocked with a passphrase that is prompted for, any other file has
+// to hold the hex encoded key. Without the flag the hex encoded key is prompted for. The key is
+// never taken as an argument, so that it doesn't end up in the shell history or the process list
+func ReadEthPrivateKey(cmd *cobra.Command, buf *bufio.Reader) (*ecdsa.PrivateKey, error) {
+	path, err := cmd.Flags().GetString(FlagEthKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		hexKey, err := input.GetPassword("Enter the hex encoded Ethereum private key:", buf)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "ethereum private key")
+		}
+		return parseEthPrivateKey(hexKey)
+	}
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ethereum key file")
+	}
+	bz = bytes.TrimSpace(bz)
+	if !bytes.HasPrefix(bz, []byte("{")) {
+		return parseEthPrivateKey(string(bz))
+	}
+	passphrase, err := input.GetPassword("Enter the passphrase of the Ethereum keystore file:", buf)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ethereum keystore passphrase")
+	}
+	key, err := keystore.DecryptKey(bz, passphrase)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ethereum keystore file")
+	}
+	return key.PrivateKey, nil
+}
+
+// parseEthPrivateKey parses a hex encoded Ethereum private key, with or without 0x prefix
+func parseEthPrivateKey(hexKey string) (*ecdsa.PrivateKey, error) {
+	privateKey, err := ethCrypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ethereum private key")
+	}
+	return privateKey, nil
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,9 +23,22 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetCurrentValset(),
 		CmdGetValsetRequest(),
 		CmdGetDelegateAddress(),
+		CmdGetOrchestratorsByValidator(),
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetBridgeHijackIncidents(),
+		CmdGetBridgeStatus(),
+		CmdGetOutboundRateLimitUsage(),
+		CmdGetQueuedDepositsByReceiver(),
+		CmdGetValidatorsMissingEthKeys(),
+		CmdGetValsetSignatures(),
+		CmdGetBatchSignatures(),
+		CmdGetLogicCallSignatures(),
+		CmdGetValsetAtEthereumHeight(),
+		CmdGetValsetAtCosmosHeight(),
+		CmdGetPendingTransfersBySender(),
+		CmdGetPendingTransfersByReceiver(),
 		// CmdGetAllOutgoingTXBatchRequest(),
 		// CmdGetOutgoingTXBatchByNonceRequest(),
 		// CmdGetAllAttestationsRequest(),
@@ -123,6 +137,36 @@ func CmdGetDelegateAddress() *cobra.Command {
 	return cmd
 }
 
+func CmdGetOrchestratorsByValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orchestrators [validator]",
+		Short: "Get all the orchestrator keys of a given validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOrchestratorsByValidatorRequest{
+				ValidatorAddress: validator.String(),
+			}
+
+			res, err := queryClient.OrchestratorsByValidator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdGetValsetRequest() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "valset-request [nonce]",
@@ -233,3 +277,342 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetBridgeHijackIncidents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-hijack-incidents",
+		Short: "Query detected bridge hijack incidents and whether the bridge is halted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeHijackIncidentsRequest{}
+
+			res, err := queryClient.BridgeHijackIncidents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-status",
+		Short: "Query whether the bridge is halted or paused and the deposits queued while paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeStatusRequest{}
+
+			res, err := queryClient.BridgeStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetOutboundRateLimitUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-rate-limit-usage [token contract]",
+		Short: "Query the amount of a token sent to Ethereum within the current rate limit window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOutboundRateLimitUsageRequest{
+				TokenContract: args[0],
+			}
+
+			res, err := queryClient.OutboundRateLimitUsage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetQueuedDepositsByReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-deposits [bech32 receiver address]",
+		Short: "Query the deposits from Ethereum to an address that are queued and not credited yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryQueuedDepositsByReceiverRequest{
+				Receiver: args[0],
+			}
+
+			res, err := queryClient.QueuedDepositsByReceiver(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValidatorsMissingEthKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missing-eth-keys",
+		Short: "Query the bonded validators without an Ethereum key, which are left out of the valset",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorsMissingEthKeysRequest{}
+
+			res, err := queryClient.ValidatorsMissingEthKeys(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsetSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-signatures [nonce]",
+		Short: "Query a valset with the signatures needed to relay it to Ethereum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetSignaturesRequest{
+				Nonce: nonce,
+			}
+
+			res, err := queryClient.ValsetSignatures(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-signatures [token contract] [nonce]",
+		Short: "Query a batch with the signatures needed to relay it to Ethereum",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchSignaturesRequest{
+				TokenContract: args[0],
+				Nonce:         nonce,
+			}
+
+			res, err := queryClient.BatchSignatures(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLogicCallSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logic-call-signatures [hex invalidation id] [invalidation nonce]",
+		Short: "Query a logic call with the signatures needed to relay it to Ethereum",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			invalidationID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			invalidationNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLogicCallSignaturesRequest{
+				InvalidationId:    invalidationID,
+				InvalidationNonce: invalidationNonce,
+			}
+
+			res, err := queryClient.LogicCallSignatures(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsetAtEthereumHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-at-ethereum-height [height]",
+		Short: "Query the valset that was active on the Gravity.sol contract at an Ethereum block height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetAtEthereumHeightRequest{
+				Height: height,
+			}
+
+			res, err := queryClient.ValsetAtEthereumHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsetAtCosmosHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-at-cosmos-height [height]",
+		Short: "Query the valset that had been observed as active on the Gravity.sol contract at a Cosmos block height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetAtCosmosHeightRequest{
+				Height: height,
+			}
+
+			res, err := queryClient.ValsetAtCosmosHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingTransfersBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-transfers-by-sender [sender-address]",
+		Short: "Query the transfers of a sender that have not been executed on Ethereum yet, including the ones in batches",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingTransfersBySenderRequest{
+				SenderAddress: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.PendingTransfersBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-transfers-by-sender")
+	return cmd
+}
+
+func CmdGetPendingTransfersByReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-transfers-by-receiver [eth-dest-address]",
+		Short: "Query the transfers to an Ethereum address that have not been executed on Ethereum yet, including the ones in batches",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingTransfersByReceiverRequest{
+				DestAddress: args[0],
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.PendingTransfersByReceiver(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-transfers-by-receiver")
+	return cmd
+}
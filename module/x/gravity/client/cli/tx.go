@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"bufio"
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -17,6 +19,13 @@ import (
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
 
+const (
+	// FlagStrict makes build-batch fail instead of creating no batch when below the threshold
+	FlagStrict = "strict"
+	// FlagMaxBatchSize limits build-batch to fewer transactions than the batch size of the token
+	FlagMaxBatchSize = "max-batch-size"
+)
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	gravityTxCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -28,8 +37,12 @@ func GetTxCmd(storeKey string) *cobra.Command {
 
 	gravityTxCmd.AddCommand([]*cobra.Command{
 		CmdSendToEth(),
+		CmdIncreaseBridgeFee(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdAddOrchestratorAddress(),
+		CmdRemoveOrchestratorAddress(),
+		CmdRotateEthKey(),
 		GetUnsafeTestingCmd(),
 	}...)
 
@@ -135,6 +148,37 @@ func CmdSendToEth() *cobra.Command {
 	return cmd
 }
 
+func CmdIncreaseBridgeFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-bridge-fee [transaction-id] [additional-fee]",
+		Short: "Adds to the bridge fee of a transaction that is still in the transaction pool so that it is batched sooner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "transaction id")
+			}
+			additionalFee, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "additional fee")
+			}
+
+			msg := types.NewMsgIncreaseBridgeFee(cliCtx.GetFromAddress(), txID, additionalFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRequestBatch() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "build-batch [token_contract_address]",
@@ -146,11 +190,21 @@ func CmdRequestBatch() *cobra.Command {
 				return err
 			}
 			cosmosAddr := cliCtx.GetFromAddress()
+			strict, err := cmd.Flags().GetBool(FlagStrict)
+			if err != nil {
+				return err
+			}
+			maxBatchSize, err := cmd.Flags().GetUint64(FlagMaxBatchSize)
+			if err != nil {
+				return err
+			}
 
 			// TODO: better denom searching
 			msg := types.MsgRequestBatch{
-				Sender: cosmosAddr.String(),
-				Denom:  fmt.Sprintf("gravity%s", args[0]),
+				Sender:       cosmosAddr.String(),
+				Denom:        fmt.Sprintf("gravity%s", args[0]),
+				Strict:       strict,
+				MaxBatchSize: maxBatchSize,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -159,32 +213,176 @@ func CmdRequestBatch() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Bool(FlagStrict, false, "fail if the batch would fall below the batch threshold of the token")
+	cmd.Flags().Uint64(FlagMaxBatchSize, 0, "the maximum number of transactions in the batch, 0 uses the batch size of the token")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
 func CmdSetOrchestratorAddress() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
+		Use:   "set-orchestrator-address [validator-address] [orchestrator-address]",
 		Short: "Allows validators to delegate their voting responsibilities to a given key.",
-		Args:  cobra.ExactArgs(3),
+		Long: `Allows validators to delegate their voting responsibilities to a given key. The Ethereum key
+signs the delegation to prove that the validator holds it, and the orchestrator has to co-sign the
+transaction. If the orchestrator is not the validator account, generate the transaction with
+--generate-only and sign it with both accounts using --sign-mode amino-json. The Ethereum key is
+read from --eth-key-file, or prompted for.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			msg := types.MsgSetOrchestratorAddress{
-				Validator:    args[0],
-				Orchestrator: args[1],
-				EthAddress:   args[2],
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator address")
+			}
+			orch, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "orchestrator address")
+			}
+			privateKey, err := ReadEthPrivateKey(cmd, bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+
+			// the signature covers the gravity id of the chain and the delegate keys nonce of the validator
+			queryClient := types.NewQueryClient(cliCtx)
+			params, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.DelegateKeysNonce(cmd.Context(), &types.QueryDelegateKeysNonceRequest{ValidatorAddress: val.String()})
+			if err != nil {
+				return err
+			}
+			hash := types.GetDelegateKeysHash(params.Params.GravityId, val, orch, res.Nonce)
+			signature, err := types.NewEthereumSignature(hash, privateKey)
+			if err != nil {
+				return err
 			}
+
+			msg := types.NewMsgSetOrchestratorAddress(val, orch, ethCrypto.PubkeyToAddress(privateKey.PublicKey).Hex(), res.Nonce, signature)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			// Send it
-			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	AddEthKeyFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAddOrchestratorAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-orchestrator-address [validator-address] [orchestrator-address]",
+		Short: "Adds another orchestrator key to a validator, up to MaxOrchestratorsPerValidator",
+		Long: `Adds another orchestrator key to a validator, which may send claims and confirms next to the
+keys it already has. The orchestrator has to co-sign the transaction, generate it with --generate-only
+and sign it with both accounts using --sign-mode amino-json.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator address")
+			}
+			orch, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "orchestrator address")
+			}
+
+			msg := types.NewMsgAddOrchestratorAddress(val, orch)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRemoveOrchestratorAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-orchestrator-address [validator-address] [orchestrator-address]",
+		Short: "Removes one of the orchestrator keys of a validator, the last one can only be replaced",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator address")
+			}
+			orch, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "orchestrator address")
+			}
+
+			msg := types.NewMsgRemoveOrchestratorAddress(val, orch)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRotateEthKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-eth-key [validator-address]",
+		Short: "Replaces the Ethereum key of a validator, signing the rotation with the new key",
+		Long: `Replaces the Ethereum key of a validator. The new key signs the rotation to prove that the
+validator holds it, the previous key stays in use for confirms until a valset including the new key
+is observed on Ethereum. The new key is read from --eth-key-file, or prompted for.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator address")
+			}
+			privateKey, err := ReadEthPrivateKey(cmd, bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+
+			// the signature covers the gravity id of the chain
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			signature, err := types.NewEthereumSignature(types.GetEthKeyRotationHash(res.Params.GravityId, val), privateKey)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateEthKey(val, ethCrypto.PubkeyToAddress(privateKey.PublicKey).Hex(), signature)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
 		},
 	}
+	AddEthKeyFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
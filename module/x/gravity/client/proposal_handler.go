@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/client/cli"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/client/rest"
+)
+
+// CancelQueuedDepositProposalHandler is the gov client handler to submit a cancel queued deposit proposal
+var CancelQueuedDepositProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCancelQueuedDepositProposal, rest.CancelQueuedDepositProposalRESTHandler)
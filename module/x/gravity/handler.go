@@ -53,6 +53,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCancelSendToEth:
 			res, err := msgServer.CancelSendToEth(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgIncreaseBridgeFee:
+			res, err := msgServer.IncreaseBridgeFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRotateEthKey:
+			res, err := msgServer.RotateEthKey(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddOrchestratorAddress:
+			res, err := msgServer.AddOrchestratorAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveOrchestratorAddress:
+			res, err := msgServer.RemoveOrchestratorAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Unrecognized Gravity Msg type: %v", msg.Type()))
@@ -2,10 +2,14 @@ package gravity
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"strings"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -263,33 +267,54 @@ func TestMsgDepositClaimsMultiValidator(t *testing.T) {
 
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
-		ethAddress                    = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+		ethKey, _                     = crypto.GenerateKey()
+		ethAddress                    = crypto.PubkeyToAddress(ethKey.PublicKey).Hex()
 		cosmosAddress  sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
-		ethAddress2                   = "0x26126048c706fB45a5a6De8432F428e794d0b952"
+		ethKey2, _                    = crypto.GenerateKey()
+		ethAddress2                   = crypto.PubkeyToAddress(ethKey2.PublicKey).Hex()
 		cosmosAddress2 sdk.AccAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
 		valAddress     sdk.ValAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
+		valAddress2    sdk.ValAddress = bytes.Repeat([]byte{0x3}, sdk.AddrLen)
 		blockTime                     = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
 		blockTime2                    = time.Date(2020, 9, 15, 15, 20, 10, 0, time.UTC)
 		blockHeight    int64          = 200
 		blockHeight2   int64          = 210
 	)
 	input := keeper.CreateTestEnv(t)
-	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress)
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress, valAddress2)
 	ctx := input.Context
 	wctx := sdk.WrapSDKContext(ctx)
 	k := input.GravityKeeper
 	h := NewHandler(input.GravityKeeper)
 	ctx = ctx.WithBlockTime(blockTime)
 
-	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, ethAddress)
+	newMsg := func(val sdk.ValAddress, orch sdk.AccAddress, key *ecdsa.PrivateKey, nonce uint64) *types.MsgSetOrchestratorAddress {
+		sig, err := types.NewEthereumSignature(types.GetDelegateKeysHash(k.GetGravityID(ctx), val, orch, nonce), key)
+		require.NoError(t, err)
+		return types.NewMsgSetOrchestratorAddress(val, orch, crypto.PubkeyToAddress(key.PublicKey).Hex(), nonce, sig)
+	}
+
+	// the ethereum key has to sign the delegation
+	msg := newMsg(valAddress, cosmosAddress, ethKey2, 0)
+	msg.EthAddress = ethAddress
 	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
 	_, err := h(ctx, msg)
+	require.Error(t, err)
+
+	msg = newMsg(valAddress, cosmosAddress, ethKey, 0)
+	_, err = h(ctx, msg)
 	require.NoError(t, err)
 
 	assert.Equal(t, k.GetEthAddressByValidator(ctx, valAddress), ethAddress)
 
 	assert.Equal(t, k.GetOrchestratorValidator(ctx, cosmosAddress), valAddress)
 
+	assert.Equal(t, uint64(1), k.GetDelegateKeysNonce(ctx, valAddress))
+
+	// the proof can't be replayed
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+
 	queryO := types.QueryDelegateKeysByOrchestratorAddress{
 		OrchestratorAddress: cosmosAddress.String(),
 	}
@@ -302,24 +327,146 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	_, err = k.GetDelegateKeyByEth(wctx, &queryE)
 	require.NoError(t, err)
 
-	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, ethAddress2)
+	// another validator can't use the same keys, however the eth address is cased
+	_, err = h(ctx, newMsg(valAddress2, cosmosAddress2, ethKey, 0))
+	require.Error(t, err)
+	msg = newMsg(valAddress2, cosmosAddress2, ethKey, 0)
+	msg.EthAddress = strings.ToLower(msg.EthAddress)
+	_, err = h(ctx, msg)
+	require.True(t, types.ErrDuplicate.Is(err))
+	_, err = h(ctx, newMsg(valAddress2, cosmosAddress, ethKey2, 0))
+	require.Error(t, err)
+
+	// a set eth address can only be changed with MsgRotateEthKey
 	ctx = ctx.WithBlockTime(blockTime2).WithBlockHeight(blockHeight2)
+	_, err = h(ctx, newMsg(valAddress, cosmosAddress2, ethKey2, 1))
+	require.Error(t, err)
+
+	msg = newMsg(valAddress, cosmosAddress2, ethKey, 1)
 	_, err = h(ctx, msg)
 	require.NoError(t, err)
 
-	assert.Equal(t, k.GetEthAddressByValidator(ctx, valAddress), ethAddress2)
+	assert.Equal(t, k.GetEthAddressByValidator(ctx, valAddress), ethAddress)
 
 	assert.Equal(t, k.GetOrchestratorValidator(ctx, cosmosAddress2), valAddress)
 
+	// the new orchestrator replaces the previous one
+	assert.Empty(t, k.GetOrchestratorValidator(ctx, cosmosAddress))
+	assert.Equal(t, []sdk.AccAddress{cosmosAddress2}, k.GetOrchestratorAddresses(ctx, valAddress))
+
 	queryO = types.QueryDelegateKeysByOrchestratorAddress{
 		OrchestratorAddress: cosmosAddress2.String(),
 	}
 	_, err = k.GetDelegateKeyByOrchestrator(wctx, &queryO)
 	require.NoError(t, err)
 
+	// the eth address that was refused doesn't point to the validator
 	queryE = types.QueryDelegateKeysByEthAddress{
 		EthAddress: ethAddress2,
 	}
 	_, err = k.GetDelegateKeyByEth(wctx, &queryE)
+	require.Error(t, err)
+
+	queryN := types.QueryDelegateKeysNonceRequest{
+		ValidatorAddress: valAddress.String(),
+	}
+	res, err := k.DelegateKeysNonce(wctx, &queryN)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Nonce)
+}
+
+func TestMsgAddRemoveOrchestratorAddresses(t *testing.T) {
+	var (
+		ethKey, _                    = crypto.GenerateKey()
+		ethAddress                   = crypto.PubkeyToAddress(ethKey.PublicKey).Hex()
+		orch1         sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+		orch2         sdk.AccAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
+		orch3         sdk.AccAddress = bytes.Repeat([]byte{0x3}, sdk.AddrLen)
+		orch4         sdk.AccAddress = bytes.Repeat([]byte{0x4}, sdk.AddrLen)
+		otherOrch     sdk.AccAddress = bytes.Repeat([]byte{0x5}, sdk.AddrLen)
+		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x6}, sdk.AddrLen)
+		otherVal      sdk.ValAddress = bytes.Repeat([]byte{0x7}, sdk.AddrLen)
+		withoutEthKey sdk.ValAddress = bytes.Repeat([]byte{0x8}, sdk.AddrLen)
+	)
+	input := keeper.CreateTestEnv(t)
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress, otherVal, withoutEthKey)
+	ctx := input.Context
+	k := input.GravityKeeper
+	h := NewHandler(input.GravityKeeper)
+	k.SetOrchestratorValidator(ctx, valAddress, orch1)
+	k.SetEthAddressForValidator(ctx, valAddress, ethAddress)
+	k.SetOrchestratorValidator(ctx, otherVal, otherOrch)
+	k.SetEthAddressForValidator(ctx, otherVal, "0xf9613b532673Cc223aBa451dFA8539B87e1F666D")
+
+	_, err := h(ctx, types.NewMsgAddOrchestratorAddress(valAddress, orch2))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgAddOrchestratorAddress(valAddress, orch3))
+	require.NoError(t, err)
+	// up to MaxOrchestratorsPerValidator keys
+	_, err = h(ctx, types.NewMsgAddOrchestratorAddress(valAddress, orch4))
+	require.Error(t, err)
+	// a key belongs to a single validator
+	_, err = h(ctx, types.NewMsgAddOrchestratorAddress(otherVal, orch2))
+	require.Error(t, err)
+	// the validator needs an eth address first
+	_, err = h(ctx, types.NewMsgAddOrchestratorAddress(withoutEthKey, orch4))
+	require.Error(t, err)
+
+	res, err := k.OrchestratorsByValidator(sdk.WrapSDKContext(ctx), &types.QueryOrchestratorsByValidatorRequest{ValidatorAddress: valAddress.String()})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{orch1.String(), orch2.String(), orch3.String()}, res.OrchestratorAddresses)
+	assert.Len(t, k.GetDelegateKeys(ctx), 4)
+
+	// any key confirms for the validator, but only once
+	valset := k.SetValsetRequest(ctx)
+	sig, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), ethKey)
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgValsetConfirm(valset.Nonce, ethAddress, orch2, hex.EncodeToString(sig)))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgValsetConfirm(valset.Nonce, ethAddress, orch1, hex.EncodeToString(sig)))
+	require.Error(t, err)
+	require.NotNil(t, k.GetValsetConfirm(ctx, valset.Nonce, valAddress))
+	assert.Equal(t, orch2.String(), k.GetValsetConfirm(ctx, valset.Nonce, valAddress).Orchestrator)
+
+	// and claims count as a single vote of the validator
+	claim := types.MsgDepositClaim{
+		EventNonce:     1,
+		TokenContract:  "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+		Amount:         sdk.NewInt(1),
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: orch4.String(),
+		Orchestrator:   orch1.String(),
+	}
+	_, err = h(ctx, &claim)
+	require.NoError(t, err)
+	claim.Orchestrator = orch3.String()
+	_, err = h(ctx, &claim)
+	require.Error(t, err)
+	att := k.GetAttestation(ctx, 1, claim.ClaimHash())
+	require.NotNil(t, att)
+	assert.Equal(t, []string{valAddress.String()}, att.Votes)
+
+	// keys can be removed by their validator, except for the last one
+	_, err = h(ctx, types.NewMsgRemoveOrchestratorAddress(otherVal, orch3))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgRemoveOrchestratorAddress(valAddress, orch3))
+	require.NoError(t, err)
+	assert.Empty(t, k.GetOrchestratorValidator(ctx, orch3))
+	_, err = h(ctx, types.NewMsgRemoveOrchestratorAddress(valAddress, orch2))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgRemoveOrchestratorAddress(valAddress, orch1))
+	require.Error(t, err)
+	assert.Equal(t, []sdk.AccAddress{orch1}, k.GetOrchestratorAddresses(ctx, valAddress))
+	// the confirm of a removed key still counts for the validator
+	assert.NotNil(t, k.GetValsetConfirm(ctx, valset.Nonce, valAddress))
+
+	// the keys and confirms survive an export and import
+	_, err = h(ctx, types.NewMsgAddOrchestratorAddress(valAddress, orch4))
 	require.NoError(t, err)
+	genesis := keeper.ExportGenesis(ctx, k)
+	fresh := keeper.CreateTestEnv(t)
+	keeper.InitGenesis(fresh.Context, fresh.GravityKeeper, genesis)
+	assert.ElementsMatch(t, []sdk.AccAddress{orch1, orch4}, fresh.GravityKeeper.GetOrchestratorAddresses(fresh.Context, valAddress))
+	assert.Equal(t, genesis.DelegateKeys, keeper.ExportGenesis(fresh.Context, fresh.GravityKeeper).DelegateKeys)
+	require.NotNil(t, fresh.GravityKeeper.GetValsetConfirm(fresh.Context, valset.Nonce, valAddress))
 }
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
@@ -24,8 +26,16 @@ func (k Keeper) Attest(
 	// We check the event nonce in processAttestation as well,
 	// but checking it here gives individual eth signers a chance to retry,
 	// and prevents validators from submitting two claims with the same nonce.
+	// The nonce is tracked per validator, not per orchestrator key, so a validator with several
+	// orchestrator keys still gets a single vote for every event.
 	// This prevents there being two attestations with the same nonce that get 2/3s of the votes
 	// in the endBlocker.
+	//
+	// Attestations up to the last slashed event nonce have already been pruned, a claim for one of those
+	// events could never be observed and would only take up space in the store.
+	if claim.GetEventNonce() <= k.GetLastSlashedAttestationNonce(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrOutdated, "event nonce already pruned")
+	}
 	lastEventNonce := k.GetLastEventNonceByValidator(ctx, valAddr)
 	if claim.GetEventNonce() != lastEventNonce+1 {
 		return nil, types.ErrNonContiguousEventNonce
@@ -48,10 +58,86 @@ func (k Keeper) Attest(
 
 	k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
 	k.setLastEventNonceByValidator(ctx, valAddr, claim.GetEventNonce())
+	k.setAttestationTallyTrigger(ctx)
 
 	return att, nil
 }
 
+// TallyAttestations counts the votes on the attestations at the next event nonce to be observed, this
+// is the only nonce at which an attestation can become observed. Once one of them passes the threshold
+// we move on to the following nonce, and stop at the first nonce where none of them does.
+//
+// The outcome only changes when a vote arrives, the power table changes or governance changes the
+// threshold, if none of that happened since the last tally there is nothing to do.
+func (k Keeper) TallyAttestations(ctx sdk.Context) {
+	threshold := k.GetAttestationVotesPowerThreshold(ctx)
+	if !k.isAttestationTallyNeeded(ctx, threshold) {
+		return
+	}
+
+	for {
+		nonce := k.GetLastObservedEventNonce(ctx) + 1
+		// collect the attestations first, observing one writes to the store we'd be iterating
+		var atts []types.Attestation
+		k.IterateAttestationsByEventNonce(ctx, nonce, func(_ []byte, att types.Attestation) bool {
+			atts = append(atts, att)
+			return false
+		})
+		// Once an attestation at this nonce becomes observed every other attestation
+		// at the same nonce is skipped, only one event can happen at a given nonce
+		for i := range atts {
+			if k.GetLastObservedEventNonce(ctx) == nonce {
+				break
+			}
+			k.TryAttestation(ctx, &atts[i])
+		}
+		if k.GetLastObservedEventNonce(ctx) != nonce {
+			break
+		}
+	}
+
+	k.setLastAttestationTally(ctx, threshold)
+}
+
+// setAttestationTallyTrigger records that the outcome of the attestation tally may have changed
+// in this block, either because a vote arrived or because the power table changed
+func (k Keeper) setAttestationTallyTrigger(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AttestationTallyTriggerKey, types.UInt64Bytes(uint64(ctx.BlockHeight())))
+}
+
+// setLastAttestationTally records the block height and the threshold of the last attestation tally
+func (k Keeper) setLastAttestationTally(ctx sdk.Context, threshold sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := threshold.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.LastAttestationTallyKey, types.UInt64Bytes(uint64(ctx.BlockHeight())))
+	store.Set(types.LastAttestationTallyThresholdKey, bz)
+}
+
+// isAttestationTallyNeeded returns true if something that can change the outcome of the attestation
+// tally happened since the last one
+func (k Keeper) isAttestationTallyNeeded(ctx sdk.Context, threshold sdk.Dec) bool {
+	store := ctx.KVStore(k.storeKey)
+	lastTally := store.Get(types.LastAttestationTallyKey)
+	if len(lastTally) == 0 {
+		return true
+	}
+	// a trigger in the same block as the last tally may have happened after it, for example
+	// when the staking EndBlocker updates the power table after ours ran, so we tally once more
+	trigger := store.Get(types.AttestationTallyTriggerKey)
+	if len(trigger) != 0 && types.UInt64FromBytes(trigger) >= types.UInt64FromBytes(lastTally) {
+		return true
+	}
+	var lastThreshold sdk.Dec
+	if err := lastThreshold.Unmarshal(store.Get(types.LastAttestationTallyThresholdKey)); err != nil {
+		panic(err)
+	}
+	return !lastThreshold.Equal(threshold)
+}
+
 // TryAttestation checks if an attestation has enough votes to be applied to the consensus state
 // and has not already been marked Observed, then calls processAttestation to actually apply it to the state,
 // and then marks it Observed and emits an event.
@@ -66,7 +152,7 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 		// Sum the current powers of all validators who have voted and see if it passes the current threshold
 		// TODO: The different integer types and math here needs a careful review
 		totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
-		requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
+		requiredPower := k.GetAttestationVotesPowerThreshold(ctx).MulInt(totalPower).TruncateInt()
 		attestationPower := sdk.NewInt(0)
 		for _, validator := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(validator)
@@ -139,6 +225,19 @@ func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim
 	ctx.EventManager().EmitEvent(observationEvent)
 }
 
+// EmitConflictingClaimEvent emits an event naming a validator who voted for a claim at an event nonce
+// where a different claim has been observed
+func (k Keeper) EmitConflictingClaimEvent(ctx sdk.Context, validator sdk.ValAddress, eventNonce uint64, observedClaimHash, conflictingClaimHash []byte) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConflictingClaim,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+		sdk.NewAttribute(types.AttributeKeyObservedClaimHash, hex.EncodeToString(observedClaimHash)),
+		sdk.NewAttribute(types.AttributeKeyConflictingClaimHash, hex.EncodeToString(conflictingClaimHash)),
+	))
+}
+
 // SetAttestation sets the attestation in the store
 func (k Keeper) SetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte, att *types.Attestation) {
 	store := ctx.KVStore(k.storeKey)
@@ -201,6 +300,71 @@ func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attest
 	}
 }
 
+// IterateAttestationsByEventNonce iterates through all attestations at a given event nonce
+func (k Keeper) IterateAttestationsByEventNonce(ctx sdk.Context, eventNonce uint64, cb func([]byte, types.Attestation) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(prefixRange(types.GetAttestationNoncePrefix(eventNonce)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		att := types.Attestation{}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		// cb returns true to stop early
+		if cb(iter.Key(), att) {
+			return
+		}
+	}
+}
+
+// GetObservedAttestation returns the observed attestation at a given event nonce, or nil
+// if none of the attestations at that nonce has been observed
+func (k Keeper) GetObservedAttestation(ctx sdk.Context, eventNonce uint64) (out *types.Attestation) {
+	k.IterateAttestationsByEventNonce(ctx, eventNonce, func(_ []byte, att types.Attestation) bool {
+		if att.Observed {
+			out = &att
+			return true
+		}
+		return false
+	})
+	return
+}
+
+// SetLastSlashedAttestationNonce sets the latest slashed attestation event nonce
+func (k Keeper) SetLastSlashedAttestationNonce(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSlashedAttestationNonce, types.UInt64Bytes(nonce))
+}
+
+// GetLastSlashedAttestationNonce returns the latest slashed attestation event nonce
+func (k Keeper) GetLastSlashedAttestationNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastSlashedAttestationNonce)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// SetLastClaimsSlashingHeight sets the current block height as the last height at which a
+// validator was slashed for missed claims
+func (k Keeper) SetLastClaimsSlashingHeight(ctx sdk.Context, validator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastClaimsSlashingHeightKey(validator), types.UInt64Bytes(uint64(ctx.BlockHeight())))
+}
+
+// GetLastClaimsSlashingHeight returns the last block height at which a validator was slashed
+// for missed claims, 0 if it never was
+func (k Keeper) GetLastClaimsSlashingHeight(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetLastClaimsSlashingHeightKey(validator))
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -310,7 +474,13 @@ func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 		}
 		return 0
 	}
-	return types.UInt64FromBytes(bytes)
+	// a validator that fell behind can't submit claims for events that have already
+	// been pruned, so they resume right after the last pruned event
+	nonce := types.UInt64FromBytes(bytes)
+	if lastSlashed := k.GetLastSlashedAttestationNonce(ctx); nonce < lastSlashed {
+		return lastSlashed
+	}
+	return nonce
 }
 
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
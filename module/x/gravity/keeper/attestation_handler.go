@@ -19,38 +19,12 @@ type AttestationHandler struct {
 func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim) error {
 	switch claim := claim.(type) {
 	case *types.MsgDepositClaim:
-		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, claim.TokenContract)
-
-		if isCosmosOriginated {
-			// If it is cosmos originated, unlock the coins
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
-		} else {
-			// If it is not cosmos originated, mint the coins (aka vouchers)
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
-			}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
+		// deposits that can't be credited yet are queued and credited by the EndBlocker
+		if a.keeper.mustQueueDeposit(ctx, claim) {
+			a.keeper.queueDeposit(ctx, claim)
+			return nil
 		}
+		return a.keeper.creditDeposit(ctx, claim)
 	case *types.MsgWithdrawClaim:
 		return a.keeper.OutgoingTxBatchExecuted(ctx, claim.TokenContract, claim.BatchNonce)
 	case *types.MsgERC20DeployedClaim:
@@ -111,12 +85,21 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		// Add to denom-erc20 mapping
 		a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, claim.TokenContract)
 	case *types.MsgValsetUpdatedClaim:
-		// TODO here we should check the contents of the validator set against
-		// the store, if they differ we should take some action to indicate to the
-		// user that bridge highjacking has occurred
-		a.keeper.SetLastObservedValset(ctx, types.Valset{
+		// check the contents of the validator set against the store, if they
+		// differ the bridge has been hijacked and outgoing traffic is halted
+		a.keeper.checkValsetUpdatedClaim(ctx, claim)
+		valset := types.Valset{
 			Nonce:   claim.ValsetNonce,
 			Members: claim.Members,
+		}
+		a.keeper.SetLastObservedValset(ctx, valset)
+		// validators whose new key is now on Ethereum can't use their previous key anymore
+		a.keeper.completeEthKeyRotations(ctx, claim.Members)
+		// archived separately from the valset requests so that it outlives their pruning
+		a.keeper.SetObservedValset(ctx, types.ObservedValset{
+			Valset:              valset,
+			EthereumBlockHeight: claim.BlockHeight,
+			CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 		})
 
 	default:
@@ -124,3 +107,41 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	}
 	return nil
 }
+
+// creditDeposit mints or unlocks the deposited tokens and sends them to the receiver
+func (k Keeper) creditDeposit(ctx sdk.Context, claim *types.MsgDepositClaim) error {
+	// Check if coin is Cosmos-originated asset and get denom
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, claim.TokenContract)
+
+	if isCosmosOriginated {
+		// If it is cosmos originated, unlock the coins
+		coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
+
+		addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid receiver address")
+		}
+
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return sdkerrors.Wrap(err, "transfer vouchers")
+		}
+	} else {
+		// If it is not cosmos originated, mint the coins (aka vouchers)
+		coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
+
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid receiver address")
+		}
+
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return sdkerrors.Wrap(err, "transfer vouchers")
+		}
+	}
+	k.addRateLimitUsage(ctx, types.InboundRateLimitUsageKey, claim.TokenContract, k.GetInboundRateLimitWindow(ctx), claim.Amount)
+	return nil
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestTallyAttestationsOnlyWhenTriggered(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	claim := depositClaim(1)
+	att := storeAttestation(t, ctx, k, claim, ValAddrs[:1])
+	k.TallyAttestations(ctx)
+	require.False(t, k.GetAttestation(ctx, 1, claim.ClaimHash()).Observed)
+
+	// the power table was set up in the same block as the last tally, so
+	// we tally once more in case it changed after the tally ran
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.True(t, k.isAttestationTallyNeeded(ctx, k.GetAttestationVotesPowerThreshold(ctx)))
+	k.TallyAttestations(ctx)
+	require.False(t, k.isAttestationTallyNeeded(ctx, k.GetAttestationVotesPowerThreshold(ctx)))
+
+	// votes that bypass Attest don't trigger a new tally
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, val := range ValAddrs[1:] {
+		att.Votes = append(att.Votes, val.String())
+	}
+	k.SetAttestation(ctx, 1, claim.ClaimHash(), att)
+	k.TallyAttestations(ctx)
+	require.False(t, k.GetAttestation(ctx, 1, claim.ClaimHash()).Observed)
+
+	// once the outcome may have changed the attestation is tallied again
+	k.setAttestationTallyTrigger(ctx)
+	k.TallyAttestations(ctx)
+	require.True(t, k.GetAttestation(ctx, 1, claim.ClaimHash()).Observed)
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx))
+}
+
+func TestTallyAttestationsObservesConsecutiveNonces(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// nonce 4 has enough votes but must wait for nonce 3
+	for nonce := uint64(1); nonce <= 4; nonce++ {
+		if nonce == 3 {
+			continue
+		}
+		storeAttestation(t, ctx, k, depositClaim(nonce), ValAddrs)
+	}
+	k.setAttestationTallyTrigger(ctx)
+	k.TallyAttestations(ctx)
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(ctx))
+
+	storeAttestation(t, ctx, k, depositClaim(3), ValAddrs)
+	k.setAttestationTallyTrigger(ctx)
+	k.TallyAttestations(ctx)
+	require.Equal(t, uint64(4), k.GetLastObservedEventNonce(ctx))
+}
+
+func BenchmarkTallyAttestations(b *testing.B) {
+	for _, history := range []uint64{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("history %d", history), func(b *testing.B) {
+			input, ctx := SetupFiveValChain(b)
+			k := input.GravityKeeper
+
+			// a long history of observed events, and a pending one that doesn't pass the threshold
+			for nonce := uint64(1); nonce <= history; nonce++ {
+				att := storeAttestation(b, ctx, k, depositClaim(nonce), ValAddrs)
+				att.Observed = true
+				k.SetAttestation(ctx, nonce, depositClaim(nonce).ClaimHash(), att)
+			}
+			k.setLastObservedEventNonce(ctx, history)
+			storeAttestation(b, ctx, k, depositClaim(history+1), ValAddrs[:1])
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+				k.setAttestationTallyTrigger(ctx)
+				k.TallyAttestations(ctx)
+			}
+		})
+	}
+}
+
+func depositClaim(nonce uint64) *types.MsgDepositClaim {
+	return &types.MsgDepositClaim{
+		EventNonce:     nonce,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(int64(nonce)),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[nonce%uint64(len(AccAddrs))].String(),
+	}
+}
+
+func storeAttestation(t testing.TB, ctx sdk.Context, k Keeper, claim *types.MsgDepositClaim, voters []sdk.ValAddress) *types.Attestation {
+	any, err := codectypes.NewAnyWithValue(claim)
+	require.NoError(t, err)
+	att := &types.Attestation{
+		Height: uint64(ctx.BlockHeight()),
+		Claim:  any,
+	}
+	for _, val := range voters {
+		att.Votes = append(att.Votes, val.String())
+	}
+	k.SetAttestation(ctx, claim.EventNonce, claim.ClaimHash(), att)
+	return att
+}
+
+func TestLastSlashedAttestationNonceGenesis(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.setLastObservedEventNonce(ctx, 5)
+	k.SetLastSlashedAttestationNonce(ctx, 3)
+
+	genesis := ExportGenesis(ctx, k)
+	require.Equal(t, uint64(3), genesis.LastSlashedAttestationNonce)
+
+	// claims for pruned events stay rejected after a restart
+	fresh := CreateTestEnv(t)
+	InitGenesis(fresh.Context, fresh.GravityKeeper, genesis)
+	require.Equal(t, uint64(3), fresh.GravityKeeper.GetLastSlashedAttestationNonce(fresh.Context))
+}
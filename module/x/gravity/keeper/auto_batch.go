@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// AutoBatchRetryBlocks is the number of blocks a token isn't auto batched for after
+// BuildOutgoingTXBatch refused its batch
+const AutoBatchRetryBlocks = 10
+
+// AutoBuildOutgoingTXBatches builds a batch for every token whose pool meets its auto batch
+// trigger. BuildOutgoingTXBatch still refuses a batch that would not be more profitable than
+// the last one of the token or falls below its batch threshold, those are retried after
+// AutoBatchRetryBlocks blocks
+func (k Keeper) AutoBuildOutgoingTXBatches(ctx sdk.Context) {
+	if k.IsBridgeHalted(ctx) || k.IsBatchCreationPaused(ctx) {
+		return
+	}
+	oldestIDs := make(map[string]uint64)
+	for _, fees := range k.getAllBatchFees(ctx, oldestIDs) {
+		if k.isAutoBatchBackingOff(ctx, fees.Token) || !k.isAutoBatchTriggered(ctx, *fees, oldestIDs[fees.Token]) {
+			continue
+		}
+
+		// build in a new Tx so that a failing batch doesn't leave any state behind
+		xCtx, commit := ctx.CacheContext()
+		batch, err := k.buildOutgoingTXBatch(xCtx, fees.Token, k.GetBatchSize(ctx, fees.Token), fees)
+		if err != nil {
+			k.logger(ctx).Debug("auto batch not built",
+				"cause", err.Error(),
+				"token", fees.Token,
+			)
+			k.setAutoBatchRefusedHeight(ctx, fees.Token)
+			continue
+		}
+		if batch == nil {
+			continue
+		}
+		commit()
+		k.deleteAutoBatchRefusedHeight(ctx, fees.Token)
+		// the cache context has its own event manager
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		k.logger(ctx).Info("auto batch built",
+			"token", fees.Token,
+			"nonce", fmt.Sprint(batch.BatchNonce),
+		)
+	}
+}
+
+// isAutoBatchBackingOff returns true if the auto batch of a token was refused less than
+// AutoBatchRetryBlocks blocks ago
+func (k Keeper) isAutoBatchBackingOff(ctx sdk.Context, tokenContract string) bool {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAutoBatchRefusedKey(tokenContract))
+	if len(bz) == 0 {
+		return false
+	}
+	return uint64(ctx.BlockHeight()) < types.UInt64FromBytes(bz)+AutoBatchRetryBlocks
+}
+
+func (k Keeper) setAutoBatchRefusedHeight(ctx sdk.Context, tokenContract string) {
+	ctx.KVStore(k.storeKey).Set(types.GetAutoBatchRefusedKey(tokenContract), types.UInt64Bytes(uint64(ctx.BlockHeight())))
+}
+
+func (k Keeper) deleteAutoBatchRefusedHeight(ctx sdk.Context, tokenContract string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetAutoBatchRefusedKey(tokenContract))
+}
+
+// GetAutoBatchTrigger returns the auto batch trigger of a token, falling back to the trigger
+// without a token contract if the token has no trigger of its own
+func (k Keeper) GetAutoBatchTrigger(ctx sdk.Context, tokenContract string) (types.AutoBatchTrigger, bool) {
+	var triggers []types.AutoBatchTrigger
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyAutoBatchTriggers, &triggers)
+
+	var (
+		global types.AutoBatchTrigger
+		found  bool
+	)
+	for _, trigger := range triggers {
+		switch {
+		case strings.EqualFold(trigger.TokenContract, tokenContract):
+			return trigger, true
+		case trigger.TokenContract == "":
+			global, found = trigger, true
+		}
+	}
+	return global, found
+}
+
+// isAutoBatchTriggered returns true if the next batch of a token would reach the min fees of
+// its auto batch trigger, or the oldest transfer in its pool, the one with oldestID, waited
+// more than the max age
+func (k Keeper) isAutoBatchTriggered(ctx sdk.Context, fees types.BatchFees, oldestID uint64) bool {
+	trigger, found := k.GetAutoBatchTrigger(ctx, fees.Token)
+	if !found {
+		return false
+	}
+	if trigger.MinFees.IsPositive() && fees.TotalFees.GTE(trigger.MinFees) {
+		return true
+	}
+	if trigger.MaxAge != 0 {
+		oldest, err := k.getPoolEntry(ctx, oldestID)
+		if err != nil {
+			panic("Invalid id in tx index!")
+		}
+		return uint64(ctx.BlockHeight()) > oldest.Block+trigger.MaxAge
+	}
+	return false
+}
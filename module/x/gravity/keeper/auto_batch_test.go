@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestAutoBuildOutgoingTXBatches(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenContract  = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			types.NewERC20Token(99999, otherTokenContract).GravityCoin(),
+		)
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	addToPool := func(ctx sdk.Context, contract string, fees ...uint64) {
+		for _, fee := range fees {
+			_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver,
+				types.NewERC20Token(100, contract).GravityCoin(), types.NewERC20Token(fee, contract).GravityCoin())
+			require.NoError(t, err)
+		}
+	}
+	autoBuild := func(ctx sdk.Context) (built int) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		k.AutoBuildOutgoingTXBatches(ctx)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeOutgoingBatch {
+				built++
+			}
+		}
+		return built
+	}
+	addToPool(ctx, myTokenContractAddr, 2, 3)
+
+	// without triggers no batches are built
+	require.Zero(t, autoBuild(ctx))
+
+	params := k.GetParams(ctx)
+	params.AutoBatchTriggers = []types.AutoBatchTrigger{
+		{TokenContract: strings.ToLower(myTokenContractAddr), MinFees: sdk.NewInt(10)},
+		{MinFees: sdk.ZeroInt(), MaxAge: 5},
+	}
+	k.SetParams(ctx, params)
+
+	// the fee trigger builds a batch once the pool reaches it
+	require.Zero(t, autoBuild(ctx))
+	addToPool(ctx, myTokenContractAddr, 6)
+	require.Equal(t, 1, autoBuild(ctx))
+	require.Len(t, k.GetOutgoingTxBatches(ctx), 1)
+	require.Zero(t, autoBuild(ctx))
+
+	// a batch below the batch threshold of the token isn't built, and the token isn't
+	// retried for AutoBatchRetryBlocks blocks
+	params.BatchThresholds = []types.BatchThreshold{{MinBatchFee: sdk.ZeroInt(), MinBatchSize: 2}}
+	k.SetParams(ctx, params)
+	addToPool(ctx, myTokenContractAddr, 10)
+	require.Zero(t, autoBuild(ctx))
+	addToPool(ctx, myTokenContractAddr, 1)
+	require.Zero(t, autoBuild(ctx.WithBlockHeight(ctx.BlockHeight()+AutoBatchRetryBlocks-1)))
+	require.Equal(t, 1, autoBuild(ctx.WithBlockHeight(ctx.BlockHeight()+AutoBatchRetryBlocks)))
+	require.Len(t, k.GetOutgoingTxBatches(ctx), 2)
+
+	// the age trigger builds a batch once the oldest transfer waited more than the max age
+	addToPool(ctx, otherTokenContract, 1)
+	addToPool(ctx.WithBlockHeight(ctx.BlockHeight()+3), otherTokenContract, 2)
+	oldestIDs := make(map[string]uint64)
+	k.getAllBatchFees(ctx, oldestIDs)
+	oldest, err := k.getPoolEntry(ctx, oldestIDs[otherTokenContract])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(ctx.BlockHeight()), oldest.Block)
+	require.Zero(t, autoBuild(ctx.WithBlockHeight(ctx.BlockHeight()+5)))
+	require.Equal(t, 1, autoBuild(ctx.WithBlockHeight(ctx.BlockHeight()+6)))
+	batch := k.GetLastOutgoingBatchByTokenType(ctx, otherTokenContract)
+	require.NotNil(t, batch)
+	assert.Len(t, batch.Transactions, 2)
+
+	// nothing is built while batch creation is paused
+	addToPool(ctx, myTokenContractAddr, 20)
+	params.BatchCreationPaused = true
+	k.SetParams(ctx, params)
+	require.Zero(t, autoBuild(ctx))
+}
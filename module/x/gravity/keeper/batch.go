@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,12 +12,14 @@ import (
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
 
+// OutgoingTxBatchSize is the batch size used if the params don't set one
 const OutgoingTxBatchSize = 100
 
 // BuildOutgoingTXBatch starts the following process chain:
 // - find bridged denominator for given voucher type
 // - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //   have a higher total fees. If not exit withtout creating a batch
+// - confirm the new batch would meet the batch threshold of the token, if not exit with ErrBatchBelowThreshold
 // - select available transactions from the outgoing transaction pool sorted by fee desc
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
@@ -27,15 +30,31 @@ func (k Keeper) BuildOutgoingTXBatch(
 	if maxElements == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
+	if k.IsBridgeHalted(ctx) {
+		return nil, types.ErrBridgeHalted
+	}
+	if k.IsBatchCreationPaused(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrBridgePaused, "batch creation")
+	}
+
+	// this traverses the current tx pool for this token type and determines what
+	// fees a hypothetical batch would have if created
+	currentFees := k.GetBatchFeesByTokenType(ctx, contractAddress, maxElements)
+	return k.buildOutgoingTXBatch(ctx, contractAddress, maxElements, currentFees)
+}
 
+// buildOutgoingTXBatch builds the batch of BuildOutgoingTXBatch out of the fees the next batch of
+// the token would have with maxElements transactions, nil if the token has none in the pool
+func (k Keeper) buildOutgoingTXBatch(
+	ctx sdk.Context,
+	contractAddress string,
+	maxElements int,
+	currentFees *types.BatchFees) (*types.OutgoingTxBatch, error) {
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contractAddress)
 
 	// lastBatch may be nil if there are no existing batches, we only need
 	// to perform this check if a previous batch exists
 	if lastBatch != nil {
-		// this traverses the current tx pool for this token type and determines what
-		// fees a hypothetical batch would have if created
-		currentFees := k.GetBatchFeesByTokenType(ctx, contractAddress)
 		if currentFees == nil {
 			return nil, sdkerrors.Wrap(types.ErrInvalid, "error getting fees from tx pool")
 		}
@@ -46,6 +65,13 @@ func (k Keeper) BuildOutgoingTXBatch(
 		}
 	}
 
+	// relayers don't pick up batches below the threshold of their token
+	if currentFees != nil {
+		if err := k.checkBatchThreshold(ctx, *currentFees); err != nil {
+			return nil, err
+		}
+	}
+
 	selectedTx, err := k.pickUnbatchedTX(ctx, contractAddress, maxElements)
 	if len(selectedTx) == 0 || err != nil {
 		return nil, err
@@ -75,6 +101,62 @@ func (k Keeper) BuildOutgoingTXBatch(
 	return batch, nil
 }
 
+// GetBatchSize returns the maximum number of transactions in a batch of a token, the
+// token batch size if the token has one or the global batch size otherwise
+func (k Keeper) GetBatchSize(ctx sdk.Context, tokenContract string) int {
+	var sizes []types.TokenBatchSize
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyTokenBatchSizes, &sizes)
+	for _, size := range sizes {
+		if strings.EqualFold(size.TokenContract, tokenContract) {
+			return int(size.BatchSize)
+		}
+	}
+
+	var size uint64
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyBatchSize, &size)
+	if size == 0 {
+		return OutgoingTxBatchSize
+	}
+	return int(size)
+}
+
+// GetBatchThreshold returns the batch threshold of a token, falling back to the threshold
+// without a token contract if the token has no threshold of its own
+func (k Keeper) GetBatchThreshold(ctx sdk.Context, tokenContract string) (types.BatchThreshold, bool) {
+	var thresholds []types.BatchThreshold
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyBatchThresholds, &thresholds)
+
+	var (
+		global types.BatchThreshold
+		found  bool
+	)
+	for _, threshold := range thresholds {
+		switch {
+		case strings.EqualFold(threshold.TokenContract, tokenContract):
+			return threshold, true
+		case threshold.TokenContract == "":
+			global, found = threshold, true
+		}
+	}
+	return global, found
+}
+
+// checkBatchThreshold returns ErrBatchBelowThreshold if a batch with the given fees would
+// fall below the MinBatchFee or MinBatchSize of its token
+func (k Keeper) checkBatchThreshold(ctx sdk.Context, fees types.BatchFees) error {
+	threshold, found := k.GetBatchThreshold(ctx, fees.Token)
+	if !found {
+		return nil
+	}
+	if fees.TotalFees.LT(threshold.MinBatchFee) {
+		return sdkerrors.Wrapf(types.ErrBatchBelowThreshold, "total fees %s below min batch fee %s", fees.TotalFees, threshold.MinBatchFee)
+	}
+	if fees.TxCount < threshold.MinBatchSize {
+		return sdkerrors.Wrapf(types.ErrBatchBelowThreshold, "%d transactions below min batch size %d", fees.TxCount, threshold.MinBatchSize)
+	}
+	return nil
+}
+
 // This gets the batch timeout height in Ethereum blocks.
 func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 	params := k.GetParams(ctx)
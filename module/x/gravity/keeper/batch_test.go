@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -61,6 +62,7 @@ func TestBatches(t *testing.T) {
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewERC20Token(101, myTokenContractAddr),
+				Block:       1234567,
 			},
 			{
 				Id:          1,
@@ -68,6 +70,7 @@ func TestBatches(t *testing.T) {
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewERC20Token(100, myTokenContractAddr),
+				Block:       1234567,
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -88,6 +91,7 @@ func TestBatches(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(102, myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          4,
@@ -95,6 +99,7 @@ func TestBatches(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(103, myTokenContractAddr),
+			Block:       1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -127,6 +132,7 @@ func TestBatches(t *testing.T) {
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewERC20Token(101, myTokenContractAddr),
+				Block:       1234567,
 			},
 			{
 				Id:          5,
@@ -134,6 +140,7 @@ func TestBatches(t *testing.T) {
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewERC20Token(100, myTokenContractAddr),
+				Block:       1234567,
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -166,6 +173,7 @@ func TestBatches(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(101, myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          1,
@@ -173,6 +181,7 @@ func TestBatches(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(100, myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          3,
@@ -180,6 +189,7 @@ func TestBatches(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(102, myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          4,
@@ -187,6 +197,7 @@ func TestBatches(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(103, myTokenContractAddr),
+			Block:       1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -247,6 +258,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Block:       1234567,
 			},
 			{
 				Id:          3,
@@ -254,6 +266,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Block:       1234567,
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -274,6 +287,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          4,
@@ -281,6 +295,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+			Block:       1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -289,7 +304,7 @@ func TestBatchesFullCoins(t *testing.T) {
 	// ====================================
 
 	// add some more TX to the pool to create a more profitable batch
-	for _, v := range []uint64{4, 5} {
+	for _, v := range []uint64{350, 5} {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
 		amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
 		fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
@@ -308,18 +323,20 @@ func TestBatchesFullCoins(t *testing.T) {
 		BatchNonce: 2,
 		Transactions: []*types.OutgoingTransferTx{
 			{
-				Id:          1,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Id:          5,
+				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(350)), myTokenContractAddr),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(350)), myTokenContractAddr),
+				Block:       1234567,
 			},
 			{
-				Id:          4,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				Id:          1,
+				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Block:       1234567,
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -352,6 +369,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          3,
@@ -359,20 +377,23 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
-			Id:          6,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
+			Id:          4,
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
-			Id:          5,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(4)), myTokenContractAddr),
+			Id:          6,
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(4)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
+			Block:       1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -433,3 +454,169 @@ func TestPoolTxRefund(t *testing.T) {
 	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
+
+func TestBatchThreshold(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenContract  = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+		myDenom             = types.NewERC20Token(1, myTokenContractAddr).GravityCoin().Denom
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	send := func(fee uint64) {
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver,
+			types.NewERC20Token(100, myTokenContractAddr).GravityCoin(), types.NewERC20Token(fee, myTokenContractAddr).GravityCoin())
+		require.NoError(t, err)
+	}
+	for _, fee := range []uint64{2, 3, 2, 1} {
+		send(fee)
+	}
+
+	params := k.GetParams(ctx)
+	params.BatchThresholds = []types.BatchThreshold{
+		{TokenContract: strings.ToLower(myTokenContractAddr), MinBatchFee: sdk.NewInt(10), MinBatchSize: 2},
+		{MinBatchFee: sdk.ZeroInt(), MinBatchSize: 5},
+	}
+	k.SetParams(ctx, params)
+
+	threshold, found := k.GetBatchThreshold(ctx, otherTokenContract)
+	require.True(t, found)
+	assert.Equal(t, uint64(5), threshold.MinBatchSize)
+
+	batchFees := k.GetAllBatchFees(ctx)
+	require.Len(t, batchFees, 1)
+	assert.Equal(t, uint64(4), batchFees[0].TxCount)
+	assert.False(t, batchFees[0].MeetsThreshold)
+
+	// a batch below the threshold is refused
+	batch, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, OutgoingTxBatchSize)
+	require.True(t, types.ErrBatchBelowThreshold.Is(err))
+	require.Nil(t, batch)
+
+	// requesting it only fails in strict mode
+	msgServer := NewMsgServerImpl(k)
+	_, err = msgServer.RequestBatch(sdk.WrapSDKContext(ctx), &types.MsgRequestBatch{Sender: mySender.String(), Denom: myDenom, Strict: true})
+	require.True(t, types.ErrBatchBelowThreshold.Is(err))
+	res, err := msgServer.RequestBatch(sdk.WrapSDKContext(ctx), &types.MsgRequestBatch{Sender: mySender.String(), Denom: myDenom})
+	require.NoError(t, err)
+	assert.Zero(t, res.BatchNonce)
+	require.Empty(t, k.GetOutgoingTxBatches(ctx))
+
+	// once the fees reach the threshold the batch is created
+	send(2)
+	batchFees = k.GetAllBatchFees(ctx)
+	require.Len(t, batchFees, 1)
+	assert.True(t, batchFees[0].MeetsThreshold)
+	res, err = msgServer.RequestBatch(sdk.WrapSDKContext(ctx), &types.MsgRequestBatch{Sender: mySender.String(), Denom: myDenom, Strict: true})
+	require.NoError(t, err)
+	assert.NotZero(t, res.BatchNonce)
+	require.Len(t, k.GetOutgoingTxBatches(ctx), 1)
+}
+
+func TestBatchMoreProfitable(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	send := func(fees ...uint64) {
+		for _, fee := range fees {
+			_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver,
+				types.NewERC20Token(100, myTokenContractAddr).GravityCoin(), types.NewERC20Token(fee, myTokenContractAddr).GravityCoin())
+			require.NoError(t, err)
+		}
+	}
+	send(2, 3)
+	first, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(5), first.GetFees())
+
+	// a batch with fewer fees than the unexecuted last batch is refused
+	send(1, 3)
+	batch, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
+	require.True(t, types.ErrInvalid.Is(err))
+	require.Nil(t, batch)
+
+	// one with at least as many fees is created
+	send(2)
+	batch, err = k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	assert.Equal(t, sdk.NewInt(5), batch.GetFees())
+}
+
+func TestBatchSize(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenContract  = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			types.NewERC20Token(99999, otherTokenContract).GravityCoin(),
+		)
+		myDenom = types.NewERC20Token(1, myTokenContractAddr).GravityCoin().Denom
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	for _, contract := range []string{myTokenContractAddr, otherTokenContract} {
+		for _, fee := range []uint64{2, 3, 2, 1, 5} {
+			_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver,
+				types.NewERC20Token(100, contract).GravityCoin(), types.NewERC20Token(fee, contract).GravityCoin())
+			require.NoError(t, err)
+		}
+	}
+
+	params := k.GetParams(ctx)
+	params.BatchSize = 4
+	params.TokenBatchSizes = []types.TokenBatchSize{{TokenContract: strings.ToLower(myTokenContractAddr), BatchSize: 3}}
+	k.SetParams(ctx, params)
+	assert.Equal(t, 3, k.GetBatchSize(ctx, myTokenContractAddr))
+	assert.Equal(t, 4, k.GetBatchSize(ctx, otherTokenContract))
+
+	// the fee projection uses the batch size of each token
+	batchFees := k.GetAllBatchFees(ctx)
+	require.Len(t, batchFees, 2)
+	for _, fees := range batchFees {
+		if fees.Token == myTokenContractAddr {
+			assert.Equal(t, uint64(3), fees.TxCount)
+			assert.Equal(t, sdk.NewInt(10), fees.TotalFees)
+		} else {
+			assert.Equal(t, uint64(4), fees.TxCount)
+			assert.Equal(t, sdk.NewInt(12), fees.TotalFees)
+		}
+	}
+
+	// a request may ask for fewer transactions, but not for more
+	msgServer := NewMsgServerImpl(k)
+	res, err := msgServer.RequestBatch(sdk.WrapSDKContext(ctx), &types.MsgRequestBatch{Sender: mySender.String(), Denom: myDenom, MaxBatchSize: 1})
+	require.NoError(t, err)
+	batch := k.GetOutgoingTXBatch(ctx, myTokenContractAddr, res.BatchNonce)
+	require.NotNil(t, batch)
+	assert.Len(t, batch.Transactions, 1)
+	assert.Equal(t, sdk.NewInt(5), batch.Transactions[0].Erc20Fee.Amount)
+
+	res, err = msgServer.RequestBatch(sdk.WrapSDKContext(ctx), &types.MsgRequestBatch{Sender: mySender.String(), Denom: myDenom, MaxBatchSize: 10})
+	require.NoError(t, err)
+	batch = k.GetOutgoingTXBatch(ctx, myTokenContractAddr, res.BatchNonce)
+	require.NotNil(t, batch)
+	assert.Len(t, batch.Transactions, 3)
+}
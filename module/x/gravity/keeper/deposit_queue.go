@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// mustQueueDeposit returns true if a deposit can't be credited right away, because inbound
+// deposits are paused, because earlier deposits of the same token are still queued or because
+// it would exceed the inbound rate limit of its token
+func (k Keeper) mustQueueDeposit(ctx sdk.Context, claim *types.MsgDepositClaim) bool {
+	return k.IsInboundDepositsPaused(ctx) ||
+		k.hasQueuedDeposits(ctx, claim.TokenContract) ||
+		!k.inboundRateLimitAllows(ctx, types.QueuedDeposit{Deposit: *claim, QueuedHeight: uint64(ctx.BlockHeight())})
+}
+
+// queueDeposit stores a deposit to be credited later by ReleaseQueuedDeposits
+func (k Keeper) queueDeposit(ctx sdk.Context, claim *types.MsgDepositClaim) {
+	k.SetQueuedDeposit(ctx, types.QueuedDeposit{Deposit: *claim, QueuedHeight: uint64(ctx.BlockHeight())})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositQueued,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.EventNonce)),
+	))
+}
+
+// SetQueuedDeposit stores a queued deposit by its token contract and event nonce, and
+// indexes it by event nonce and receiver
+func (k Keeper) SetQueuedDeposit(ctx sdk.Context, deposit types.QueuedDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	tokenContract := normalizeTokenContract(deposit.Deposit.TokenContract)
+	store.Set(types.GetQueuedDepositKey(tokenContract, deposit.Deposit.EventNonce), k.cdc.MustMarshalBinaryBare(&deposit))
+	store.Set(types.GetQueuedDepositByNonceKey(deposit.Deposit.EventNonce), []byte(tokenContract))
+	if receiver, err := sdk.AccAddressFromBech32(deposit.Deposit.CosmosReceiver); err == nil {
+		store.Set(types.GetQueuedDepositByReceiverKey(receiver, deposit.Deposit.EventNonce), []byte{})
+	}
+}
+
+// getQueuedDeposit returns the queued deposit with an event nonce, if any
+func (k Keeper) getQueuedDeposit(ctx sdk.Context, eventNonce uint64) *types.QueuedDeposit {
+	store := ctx.KVStore(k.storeKey)
+	tokenContract := store.Get(types.GetQueuedDepositByNonceKey(eventNonce))
+	if tokenContract == nil {
+		return nil
+	}
+	bz := store.Get(types.GetQueuedDepositKey(string(tokenContract), eventNonce))
+	if bz == nil {
+		panic("Invalid event nonce in queued deposit index!")
+	}
+	var deposit types.QueuedDeposit
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return &deposit
+}
+
+// deleteQueuedDeposit removes a deposit from the queue and its indexes
+func (k Keeper) deleteQueuedDeposit(ctx sdk.Context, deposit types.QueuedDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedDepositKey(normalizeTokenContract(deposit.Deposit.TokenContract), deposit.Deposit.EventNonce))
+	store.Delete(types.GetQueuedDepositByNonceKey(deposit.Deposit.EventNonce))
+	if receiver, err := sdk.AccAddressFromBech32(deposit.Deposit.CosmosReceiver); err == nil {
+		store.Delete(types.GetQueuedDepositByReceiverKey(receiver, deposit.Deposit.EventNonce))
+	}
+}
+
+// hasQueuedDeposits returns true if there are queued deposits of a token
+func (k Keeper) hasQueuedDeposits(ctx sdk.Context, tokenContract string) bool {
+	return k.getFirstQueuedDeposit(ctx, normalizeTokenContract(tokenContract)) != nil
+}
+
+// getFirstQueuedDeposit returns the queued deposit of a normalized token contract with the
+// lowest event nonce, if any
+func (k Keeper) getFirstQueuedDeposit(ctx sdk.Context, tokenContract string) *types.QueuedDeposit {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueuedDepositPrefix(tokenContract))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	var deposit types.QueuedDeposit
+	k.cdc.MustUnmarshalBinaryBare(iter.Value(), &deposit)
+	return &deposit
+}
+
+// getNextQueuedDepositToken returns the first normalized token contract with queued deposits
+// from the queue key start on
+func (k Keeper) getNextQueuedDepositToken(ctx sdk.Context, start []byte) (string, bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedDepositKey)
+	iter := prefixStore.Iterator(start, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return "", false
+	}
+	// the key is the token contract followed by the event nonce
+	key := iter.Key()
+	return string(key[:len(key)-8]), true
+}
+
+// IterateQueuedDeposits iterates through all queued deposits by token contract and event nonce
+// in ASC order
+func (k Keeper) IterateQueuedDeposits(ctx sdk.Context, cb func([]byte, types.QueuedDeposit) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedDepositKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.QueuedDeposit
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &deposit)
+		// cb returns true to stop early
+		if cb(iter.Key(), deposit) {
+			break
+		}
+	}
+}
+
+// GetQueuedDeposits returns all deposits that are not credited yet
+func (k Keeper) GetQueuedDeposits(ctx sdk.Context) (out []types.QueuedDeposit) {
+	k.IterateQueuedDeposits(ctx, func(_ []byte, deposit types.QueuedDeposit) bool {
+		out = append(out, deposit)
+		return false
+	})
+	return
+}
+
+// GetQueuedDepositsByReceiver returns all deposits to a Cosmos address that are not credited yet
+// in event nonce order
+func (k Keeper) GetQueuedDepositsByReceiver(ctx sdk.Context, receiver string) (out []types.QueuedDeposit) {
+	addr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return nil
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueuedDepositByReceiverPrefix(addr))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		deposit := k.getQueuedDeposit(ctx, types.UInt64FromBytes(iter.Key()))
+		if deposit == nil {
+			panic("Invalid event nonce in queued deposit receiver index!")
+		}
+		out = append(out, *deposit)
+	}
+	return
+}
+
+// CancelQueuedDeposit removes a deposit from the queue without crediting it, the deposited
+// tokens stay locked in the bridge contract on Ethereum
+func (k Keeper) CancelQueuedDeposit(ctx sdk.Context, eventNonce uint64) error {
+	deposit := k.getQueuedDeposit(ctx, eventNonce)
+	if deposit == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "no queued deposit with event nonce %d", eventNonce)
+	}
+	k.deleteQueuedDeposit(ctx, *deposit)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeQueuedDepositCanceled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+	))
+	return nil
+}
+
+// ReleaseQueuedDeposits credits queued deposits per token in the order they were observed,
+// as long as inbound deposits are not paused and the inbound rate limit of the token allows it.
+// A deposit above the window limit of its token is credited in parts
+func (k Keeper) ReleaseQueuedDeposits(ctx sdk.Context) {
+	if k.IsInboundDepositsPaused(ctx) {
+		return
+	}
+	// the queue is keyed by token contract and event nonce, so the deposits of a token are
+	// released from the start of its prefix and the queued deposits behind the first one that
+	// has to wait are never loaded
+	var start []byte
+	for {
+		tokenContract, found := k.getNextQueuedDepositToken(ctx, start)
+		if !found {
+			return
+		}
+		k.releaseQueuedDepositsOfToken(ctx, tokenContract)
+		if _, start = prefixRange([]byte(tokenContract)); start == nil {
+			return
+		}
+	}
+}
+
+// releaseQueuedDepositsOfToken credits the queued deposits of a normalized token contract in
+// event nonce order, once a deposit has to wait all later deposits of the token wait as well
+func (k Keeper) releaseQueuedDepositsOfToken(ctx sdk.Context, tokenContract string) {
+	for {
+		deposit := k.getFirstQueuedDeposit(ctx, tokenContract)
+		if deposit == nil {
+			return
+		}
+		if !k.inboundRateLimitAllows(ctx, *deposit) {
+			k.releaseQueuedDepositPart(ctx, *deposit)
+			return
+		}
+		k.deleteQueuedDeposit(ctx, *deposit)
+
+		// credit in a new Tx so that a failing deposit doesn't affect the others, same
+		// as when the deposit is credited on observation
+		xCtx, commit := ctx.CacheContext()
+		if err := k.creditDeposit(xCtx, &deposit.Deposit); err != nil {
+			k.logger(ctx).Error("queued deposit failed",
+				"cause", err.Error(),
+				"nonce", fmt.Sprint(deposit.Deposit.EventNonce),
+			)
+			continue
+		}
+		commit()
+	}
+}
+
+// releaseQueuedDepositPart credits as much of a deposit above the window limit of its token as
+// the window has room for. The rest stays queued with its amount reduced until the window has
+// room again, once it is within the window limit it waits to be credited in full like any other
+// deposit
+func (k Keeper) releaseQueuedDepositPart(ctx sdk.Context, deposit types.QueuedDeposit) {
+	limit, _ := k.GetInboundRateLimit(ctx, deposit.Deposit.TokenContract)
+	if deposit.Deposit.Amount.LTE(limit.WindowLimit) {
+		return
+	}
+	room := limit.WindowLimit.Sub(k.GetInboundRateLimitUsage(ctx, deposit.Deposit.TokenContract))
+	if !room.IsPositive() {
+		return
+	}
+
+	part := deposit.Deposit
+	part.Amount = room
+	xCtx, commit := ctx.CacheContext()
+	if err := k.creditDeposit(xCtx, &part); err != nil {
+		// the rest would fail the same way, drop it as a deposit failing in full would be
+		k.logger(ctx).Error("queued deposit failed",
+			"cause", err.Error(),
+			"nonce", fmt.Sprint(deposit.Deposit.EventNonce),
+		)
+		k.deleteQueuedDeposit(ctx, deposit)
+		return
+	}
+	commit()
+
+	deposit.Deposit.Amount = deposit.Deposit.Amount.Sub(room)
+	k.SetQueuedDeposit(ctx, deposit)
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// RotateEthAddressForValidator replaces the Ethereum address of a validator and starts the grace
+// period of the previous address. The previous address keeps its reverse index, so signatures made
+// with it can still be attributed to the validator, until the rotation is completed
+func (k Keeper) RotateEthAddressForValidator(ctx sdk.Context, validator sdk.ValAddress, newEthAddr string) {
+	newEthAddr = normalizeEthAddress(newEthAddr)
+	rotation := types.EthKeyRotation{
+		Validator:          validator.String(),
+		PreviousEthAddress: k.GetEthAddressByValidator(ctx, validator),
+		NewEthAddress:      newEthAddr,
+		Height:             uint64(ctx.BlockHeight()),
+	}
+	k.SetEthKeyRotation(ctx, rotation)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEthAddressByValidatorKey(validator), []byte(newEthAddr))
+	store.Set(types.GetValidatorByEthAddressKey(newEthAddr), []byte(validator))
+}
+
+// SetEthKeyRotation stores an Ethereum key rotation in its grace period
+func (k Keeper) SetEthKeyRotation(ctx sdk.Context, rotation types.EthKeyRotation) {
+	val, err := sdk.ValAddressFromBech32(rotation.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEthKeyRotationKey(val), k.cdc.MustMarshalBinaryBare(&rotation))
+}
+
+// GetEthKeyRotation returns the Ethereum key rotation of a validator that is in its grace period,
+// or nil if there is none
+func (k Keeper) GetEthKeyRotation(ctx sdk.Context, validator sdk.ValAddress) *types.EthKeyRotation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEthKeyRotationKey(validator))
+	if len(bz) == 0 {
+		return nil
+	}
+	var rotation types.EthKeyRotation
+	k.cdc.MustUnmarshalBinaryBare(bz, &rotation)
+	return &rotation
+}
+
+// IterateEthKeyRotations iterates through the Ethereum key rotations in their grace period
+func (k Keeper) IterateEthKeyRotations(ctx sdk.Context, cb func([]byte, types.EthKeyRotation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EthKeyRotationKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.EthKeyRotation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &rotation)
+		// cb returns true to stop early
+		if cb(iter.Key(), rotation) {
+			break
+		}
+	}
+}
+
+// GetEthKeyRotations returns all Ethereum key rotations in their grace period
+func (k Keeper) GetEthKeyRotations(ctx sdk.Context) (out []types.EthKeyRotation) {
+	k.IterateEthKeyRotations(ctx, func(_ []byte, rotation types.EthKeyRotation) bool {
+		out = append(out, rotation)
+		return false
+	})
+	return
+}
+
+// completeEthKeyRotation ends the grace period of a rotation, after which only the new
+// Ethereum address is accepted, and removes the reverse index of the previous address
+func (k Keeper) completeEthKeyRotation(ctx sdk.Context, validator sdk.ValAddress, rotation types.EthKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEthKeyRotationKey(validator))
+	k.deleteValidatorByEthAddress(ctx, validator, rotation.PreviousEthAddress)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEthKeyRotationCompleted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, rotation.Validator),
+		sdk.NewAttribute(types.AttributeKeyEthAddress, rotation.NewEthAddress),
+		sdk.NewAttribute(types.AttributeKeyPreviousEthAddress, rotation.PreviousEthAddress),
+	))
+}
+
+// completeEthKeyRotations completes the rotations whose new Ethereum address is a member of a
+// valset observed on Ethereum, from then on the Gravity.sol contract only accepts the new key
+func (k Keeper) completeEthKeyRotations(ctx sdk.Context, members []*types.BridgeValidator) {
+	observed := make(map[string]bool, len(members))
+	for _, m := range members {
+		observed[strings.ToLower(m.EthereumAddress)] = true
+	}
+	var completed []types.EthKeyRotation
+	k.IterateEthKeyRotations(ctx, func(_ []byte, rotation types.EthKeyRotation) bool {
+		if observed[strings.ToLower(rotation.NewEthAddress)] {
+			completed = append(completed, rotation)
+		}
+		return false
+	})
+	for _, rotation := range completed {
+		val, err := sdk.ValAddressFromBech32(rotation.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid validator address"))
+		}
+		k.completeEthKeyRotation(ctx, val, rotation)
+	}
+}
+
+// CompleteTimedOutEthKeyRotations completes the rotations started more than timeout blocks ago,
+// the valsets created since carry the new key, so it is the one the contract learns eventually
+// even if the valset that introduces it wasn't relayed in time
+func (k Keeper) CompleteTimedOutEthKeyRotations(ctx sdk.Context, timeout uint64) {
+	currentHeight := uint64(ctx.BlockHeight())
+	var timedOut []types.EthKeyRotation
+	k.IterateEthKeyRotations(ctx, func(_ []byte, rotation types.EthKeyRotation) bool {
+		if rotation.Height+timeout <= currentHeight {
+			timedOut = append(timedOut, rotation)
+		}
+		return false
+	})
+	for _, rotation := range timedOut {
+		val, err := sdk.ValAddressFromBech32(rotation.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid validator address"))
+		}
+		k.completeEthKeyRotation(ctx, val, rotation)
+	}
+}
+
+// isEthAddressSigning returns whether an Ethereum address is a member of the current valset or of
+// the valset the Gravity.sol contract holds, only then are its signatures needed for relays
+func (k Keeper) isEthAddressSigning(ctx sdk.Context, ethAddr string) bool {
+	valsets := []*types.Valset{k.GetCurrentValset(ctx), k.getRelaySignerValset(ctx)}
+	for _, valset := range valsets {
+		if valset == nil {
+			continue
+		}
+		for _, m := range valset.Members {
+			if strings.EqualFold(m.EthereumAddress, ethAddr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getConfirmEthAddress returns the Ethereum address a validator has to sign confirms with. Until
+// a rotation is completed the Gravity.sol contract only knows the previous key, so its signatures
+// are needed to relay pending valsets, batches and logic calls, and the valset that introduces
+// the new key. Signatures by the new key would leave the validator's power out of the relay
+func (k Keeper) getConfirmEthAddress(ctx sdk.Context, validator sdk.ValAddress) string {
+	if rotation := k.GetEthKeyRotation(ctx, validator); rotation != nil {
+		return rotation.PreviousEthAddress
+	}
+	return k.GetEthAddressByValidator(ctx, validator)
+}
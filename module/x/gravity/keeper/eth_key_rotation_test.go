@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestRotateEthKey(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	handler := AttestationHandler{keeper: k, bankKeeper: input.BankKeeper}
+	gravityID := k.GetGravityID(ctx)
+	val := ValAddrs[0]
+	k.SetOrchestratorValidator(ctx, val, AccAddrs[0])
+
+	newKey := func() (*ecdsa.PrivateKey, string) {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		return key, crypto.PubkeyToAddress(key.PublicKey).Hex()
+	}
+	oldKey, oldAddr := newKey()
+	k.SetEthAddressForValidator(ctx, val, oldAddr)
+	confirmValset := func(ctx sdk.Context, valset *types.Valset, key *ecdsa.PrivateKey) (*types.MsgValsetConfirm, error) {
+		sig, err := types.NewEthereumSignature(valset.GetCheckpoint(gravityID), key)
+		require.NoError(t, err)
+		msg := types.NewMsgValsetConfirm(valset.Nonce, "", AccAddrs[0], hex.EncodeToString(sig))
+		_, err = msgServer.ValsetConfirm(sdk.WrapSDKContext(ctx), msg)
+		return msg, err
+	}
+	pending := k.SetValsetRequest(ctx)
+
+	// the new key has to sign the rotation
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	rotKey, rotAddr := newKey()
+	otherKey, _ := newKey()
+	badSig, err := types.NewEthereumSignature(types.GetEthKeyRotationHash(gravityID, val), otherKey)
+	require.NoError(t, err)
+	_, err = msgServer.RotateEthKey(sdk.WrapSDKContext(ctx), types.NewMsgRotateEthKey(val, rotAddr, badSig))
+	require.Error(t, err)
+	sig, err := types.NewEthereumSignature(types.GetEthKeyRotationHash(gravityID, val), rotKey)
+	require.NoError(t, err)
+	_, err = msgServer.RotateEthKey(sdk.WrapSDKContext(ctx), types.NewMsgRotateEthKey(val, rotAddr, sig))
+	require.NoError(t, err)
+
+	assert.Equal(t, rotAddr, k.GetEthAddressByValidator(ctx, val))
+	assert.Equal(t, val, k.GetValidatorAddressByEthAddress(ctx, rotAddr))
+	assert.Equal(t, val, k.GetValidatorAddressByEthAddress(ctx, oldAddr))
+	assert.Equal(t, uint64(ctx.BlockHeight()), k.GetValsetUpdateRequestHeight(ctx))
+	require.Equal(t, &types.EthKeyRotation{
+		Validator:          val.String(),
+		PreviousEthAddress: oldAddr,
+		NewEthAddress:      rotAddr,
+		Height:             uint64(ctx.BlockHeight()),
+	}, k.GetEthKeyRotation(ctx, val))
+
+	// only one rotation at a time
+	_, anotherAddr := newKey()
+	_, err = msgServer.RotateEthKey(sdk.WrapSDKContext(ctx), types.NewMsgRotateEthKey(val, anotherAddr, sig))
+	require.Error(t, err)
+
+	// during the grace period the previous key confirms what is pending
+	confirm, err := confirmValset(ctx, pending, oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, oldAddr, k.GetValsetConfirm(ctx, pending.Nonce, val).EthAddress)
+	assert.Equal(t, oldAddr, confirm.EthAddress)
+
+	// the next valset includes the new key, but the contract only knows the previous one,
+	// so the previous key has to sign it for the validator's power to count in the relay
+	next := k.SetValsetRequest(ctx)
+	var found bool
+	for _, m := range next.Members {
+		found = found || m.EthereumAddress == rotAddr
+	}
+	require.True(t, found)
+	_, err = confirmValset(ctx, next, rotKey)
+	require.Error(t, err)
+	_, err = confirmValset(ctx, next, oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, oldAddr, k.GetValsetConfirm(ctx, next.Nonce, val).EthAddress)
+
+	// observing the valset with the new key ends the grace period
+	claim := &types.MsgValsetUpdatedClaim{EventNonce: 1, ValsetNonce: next.Nonce, BlockHeight: 100, Members: next.Members}
+	require.NoError(t, handler.Handle(ctx, types.Attestation{}, claim))
+	assert.Nil(t, k.GetEthKeyRotation(ctx, val))
+	assert.Empty(t, k.GetValidatorAddressByEthAddress(ctx, oldAddr))
+	assert.Equal(t, val, k.GetValidatorAddressByEthAddress(ctx, rotAddr))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	last := k.SetValsetRequest(ctx)
+	_, err = confirmValset(ctx, last, oldKey)
+	require.Error(t, err)
+	_, err = confirmValset(ctx, last, rotKey)
+	require.NoError(t, err)
+
+	// an address in use by another validator can't be rotated to
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	sig, err = types.NewEthereumSignature(types.GetEthKeyRotationHash(gravityID, ValAddrs[1]), rotKey)
+	require.NoError(t, err)
+	_, err = msgServer.RotateEthKey(sdk.WrapSDKContext(ctx), types.NewMsgRotateEthKey(ValAddrs[1], rotAddr, sig))
+	require.Error(t, err)
+}
+
+func TestEthKeyRotationTimeout(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	val := ValAddrs[0]
+	oldAddr := k.GetEthAddressByValidator(ctx, val)
+	require.NotEmpty(t, oldAddr)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newAddr := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	sig, err := types.NewEthereumSignature(types.GetEthKeyRotationHash(k.GetGravityID(ctx), val), key)
+	require.NoError(t, err)
+	_, err = msgServer.RotateEthKey(sdk.WrapSDKContext(ctx), types.NewMsgRotateEthKey(val, newAddr, sig))
+	require.NoError(t, err)
+	require.NotNil(t, k.GetEthKeyRotation(ctx, val))
+
+	// the rotation outlives its valset until the timeout has passed
+	timeout := k.GetParams(ctx).EthKeyRotationTimeout
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(timeout) - 1)
+	k.CompleteTimedOutEthKeyRotations(ctx, timeout)
+	require.NotNil(t, k.GetEthKeyRotation(ctx, val))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.CompleteTimedOutEthKeyRotations(ctx, timeout)
+	assert.Nil(t, k.GetEthKeyRotation(ctx, val))
+	assert.Empty(t, k.GetValidatorAddressByEthAddress(ctx, oldAddr))
+	assert.Equal(t, val, k.GetValidatorAddressByEthAddress(ctx, newAddr))
+}
+
+func TestRotateEthKeyOutsideValset(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	val := ValAddrs[0]
+	oldAddr := k.GetEthAddressByValidator(ctx, val)
+	require.NotEmpty(t, oldAddr)
+
+	// an unbonding validator is neither in the current valset nor in one on Ethereum
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(ctx, NewTestMsgUnDelegateValidator(val, StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	require.Nil(t, k.getRelaySignerValset(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newAddr := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	sig, err := types.NewEthereumSignature(types.GetEthKeyRotationHash(k.GetGravityID(ctx), val), key)
+	require.NoError(t, err)
+	_, err = msgServer.RotateEthKey(sdk.WrapSDKContext(ctx), types.NewMsgRotateEthKey(val, newAddr, sig))
+	require.NoError(t, err)
+
+	// there is no power the previous key has to keep signing for, so the rotation completes at once
+	assert.Nil(t, k.GetEthKeyRotation(ctx, val))
+	assert.Empty(t, k.GetValidatorAddressByEthAddress(ctx, oldAddr))
+	assert.Equal(t, val, k.GetValidatorAddressByEthAddress(ctx, newAddr))
+	assert.NotEqual(t, uint64(ctx.BlockHeight()), k.GetValsetUpdateRequestHeight(ctx))
+}
@@ -84,7 +84,7 @@ func TestSubmitBadSignatureEvidenceLogicCallExists(t *testing.T) {
 		Timeout: 420,
 	}
 
-	input.GravityKeeper.SetOutgoingLogicCall(ctx, &logicCall)
+	input.GravityKeeper.setOutgoingLogicCall(ctx, &logicCall)
 
 	any, _ := codectypes.NewAnyWithValue(&logicCall)
 
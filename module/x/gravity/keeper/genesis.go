@@ -9,45 +9,47 @@ import (
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
-	// reset valsets in state
+	// reset valsets in state, keeping their nonces so that new valsets continue after
+	// the last one the bridge contract may have seen. Valsets exported without a height
+	// or by a chain whose height was reset are treated as created at genesis
 	for _, vs := range data.Valsets {
-		// TODO: block height?
+		if vs.Height == 0 || vs.Height > uint64(ctx.BlockHeight()) {
+			vs.Height = uint64(ctx.BlockHeight())
+		}
 		k.StoreValsetUnsafe(ctx, vs)
 	}
 
-	// reset valset confirmations in state
-	for _, conf := range data.ValsetConfirms {
-		k.SetValsetConfirm(ctx, *conf)
-	}
-
-	// reset batches in state
+	// reset batches in state, the transfers in batches stay in the pool until the batch is
+	// executed so that they can be released if it times out
 	for _, batch := range data.Batches {
 		// TODO: block height?
 		k.StoreBatchUnsafe(ctx, batch)
+		for _, tx := range batch.Transactions {
+			if err := k.setPoolEntry(ctx, tx); err != nil {
+				panic(err)
+			}
+		}
 	}
 
-	// reset batch confirmations in state
-	for _, conf := range data.BatchConfirms {
-		conf := conf
-		k.SetBatchConfirm(ctx, &conf)
-	}
-
-	// reset logic calls in state
+	// reset logic calls in state, calls exported without a block or by a chain whose
+	// height was reset are treated as created at genesis
 	for _, call := range data.LogicCalls {
-		k.SetOutgoingLogicCall(ctx, call)
-	}
-
-	// reset batch confirmations in state
-	for _, conf := range data.LogicCallConfirms {
-		conf := conf
-		k.SetLogicCallConfirm(ctx, &conf)
+		if call.Block == 0 || call.Block > uint64(ctx.BlockHeight()) {
+			call.Block = uint64(ctx.BlockHeight())
+		}
+		k.setOutgoingLogicCall(ctx, call)
 	}
 
-	// reset pool transactions in state
+	// reset pool transactions in state, transfers exported without a block or by a chain
+	// whose height was reset are treated as added to the pool at genesis
 	for _, tx := range data.UnbatchedTransfers {
+		if tx.Block == 0 || tx.Block > uint64(ctx.BlockHeight()) {
+			tx.Block = uint64(ctx.BlockHeight())
+		}
 		if err := k.setPoolEntry(ctx, tx); err != nil {
 			panic(err)
 		}
+		k.appendToUnbatchedTXIndex(ctx, tx.Erc20Fee.Contract, *tx.Erc20Fee, tx.Id)
 	}
 
 	// reset attestations in state
@@ -62,6 +64,12 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), &att)
 	}
 	k.setLastObservedEventNonce(ctx, data.LastObservedNonce)
+	// a genesis exported before claims were slashed has no last slashed attestation nonce, its
+	// observed events are not slashed in retrospect
+	if data.LastSlashedAttestationNonce == 0 {
+		data.LastSlashedAttestationNonce = data.LastObservedNonce
+	}
+	k.SetLastSlashedAttestationNonce(ctx, data.LastSlashedAttestationNonce)
 
 	// reset attestation state of specific validators
 	// this must be done after the above to be correct
@@ -94,7 +102,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 
 	// reset delegate keys in state
 	for _, keys := range data.DelegateKeys {
-		err := keys.ValidateBasic()
+		err := keys.ValidateDelegateKeys()
 		if err != nil {
 			panic("Invalid delegate key in Genesis!")
 		}
@@ -112,12 +120,59 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetOrchestratorValidator(ctx, val, orch)
 		// set the ethereum address
 		k.SetEthAddressForValidator(ctx, val, keys.EthAddress)
+		k.setDelegateKeysNonce(ctx, val, keys.Nonce)
+	}
+
+	// reset eth key rotations in their grace period, the previous addresses keep
+	// pointing to their validators until the rotations are completed
+	for _, rotation := range data.EthKeyRotations {
+		val, err := sdk.ValAddressFromBech32(rotation.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.SetEthKeyRotation(ctx, rotation)
+		ctx.KVStore(k.storeKey).Set(types.GetValidatorByEthAddressKey(rotation.PreviousEthAddress), val)
+	}
+
+	// reset confirmations in state, this must be done after the delegate keys and
+	// eth key rotations since confirms are stored by the validator that made them
+	for _, conf := range data.ValsetConfirms {
+		if val := k.getConfirmValidator(ctx, conf.Orchestrator, conf.EthAddress); val != nil {
+			k.SetValsetConfirm(ctx, val, *conf)
+		}
+	}
+	for _, conf := range data.BatchConfirms {
+		conf := conf
+		if val := k.getConfirmValidator(ctx, conf.Orchestrator, conf.EthSigner); val != nil {
+			k.SetBatchConfirm(ctx, val, &conf)
+		}
+	}
+	for _, conf := range data.LogicCallConfirms {
+		conf := conf
+		if val := k.getConfirmValidator(ctx, conf.Orchestrator, conf.EthSigner); val != nil {
+			k.SetLogicCallConfirm(ctx, val, &conf)
+		}
 	}
 
 	// populate state with cosmos originated denom-erc20 mapping
 	for _, item := range data.Erc20ToDenoms {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, item.Erc20)
 	}
+
+	// reset bridge hijack incidents in state
+	for _, incident := range data.BridgeHijackIncidents {
+		k.SetBridgeHijackIncident(ctx, incident)
+	}
+
+	// reset deposits queued while inbound deposits are paused
+	for _, deposit := range data.QueuedDeposits {
+		k.SetQueuedDeposit(ctx, deposit)
+	}
+
+	// reset the archive of observed valsets
+	for _, observed := range data.ObservedValsets {
+		k.SetObservedValset(ctx, observed)
+	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -137,6 +192,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastobserved       = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms      = []*types.ERC20ToDenom{}
 		unbatchedTransfers = k.GetPoolTransactions(ctx)
+		hijackIncidents    = k.GetBridgeHijackIncidents(ctx)
+		queuedDeposits     = k.GetQueuedDeposits(ctx)
+		observedValsets    = k.GetObservedValsets(ctx)
+		ethKeyRotations    = k.GetEthKeyRotations(ctx)
+		lastSlashed        = k.GetLastSlashedAttestationNonce(ctx)
 	)
 
 	// export valset confirmations from state
@@ -172,17 +232,37 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:             &p,
-		LastObservedNonce:  lastobserved,
-		Valsets:            valsets,
-		ValsetConfirms:     vsconfs,
-		Batches:            batches,
-		BatchConfirms:      batchconfs,
-		LogicCalls:         calls,
-		LogicCallConfirms:  callconfs,
-		Attestations:       attestations,
-		DelegateKeys:       delegates,
-		Erc20ToDenoms:      erc20ToDenoms,
-		UnbatchedTransfers: unbatchedTransfers,
+		Params:                      &p,
+		LastObservedNonce:           lastobserved,
+		Valsets:                     valsets,
+		ValsetConfirms:              vsconfs,
+		Batches:                     batches,
+		BatchConfirms:               batchconfs,
+		LogicCalls:                  calls,
+		LogicCallConfirms:           callconfs,
+		Attestations:                attestations,
+		DelegateKeys:                delegates,
+		Erc20ToDenoms:               erc20ToDenoms,
+		UnbatchedTransfers:          unbatchedTransfers,
+		BridgeHijackIncidents:       hijackIncidents,
+		QueuedDeposits:              queuedDeposits,
+		ObservedValsets:             observedValsets,
+		EthKeyRotations:             ethKeyRotations,
+		LastSlashedAttestationNonce: lastSlashed,
+	}
+}
+
+// getConfirmValidator returns the validator that made an exported confirm, by its orchestrator
+// key or, if that key was removed since, by the Ethereum key that signed it. Returns nil if the
+// confirm can't be attributed to a validator anymore
+func (k Keeper) getConfirmValidator(ctx sdk.Context, orchestrator, ethSigner string) sdk.ValAddress {
+	if orch, err := sdk.AccAddressFromBech32(orchestrator); err == nil {
+		if val := k.GetOrchestratorValidator(ctx, orch); len(val) != 0 {
+			return val
+		}
+	}
+	if val := k.GetValidatorAddressByEthAddress(ctx, ethSigner); len(val) != 0 {
+		return val
 	}
+	return nil
 }
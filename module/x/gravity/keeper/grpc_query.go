@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
 )
@@ -41,7 +43,10 @@ func (k Keeper) ValsetConfirm(
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
-	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(sdk.UnwrapSDKContext(c), req.Nonce, addr)}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	// confirms are stored by validator, any of its orchestrator keys finds the confirm
+	validator := k.GetOrchestratorValidator(ctx, addr)
+	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(ctx, req.Nonce, validator)}, nil
 }
 
 // ValsetConfirmsByNonce queries the ValsetConfirmsByNonce of the gravity module
@@ -80,10 +85,12 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
+	ctx := sdk.UnwrapSDKContext(c)
+	validator := k.GetOrchestratorValidator(ctx, addr)
 	var pendingValsetReq []*types.Valset
-	k.IterateValsets(sdk.UnwrapSDKContext(c), func(_ []byte, val *types.Valset) bool {
-		// foundConfirm is true if the operatorAddr has signed the valset we are currently looking at
-		foundConfirm := k.GetValsetConfirm(sdk.UnwrapSDKContext(c), val.Nonce, addr) != nil
+	k.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
+		// foundConfirm is true if the validator of the operatorAddr has signed the valset we are currently looking at
+		foundConfirm := k.GetValsetConfirm(ctx, val.Nonce, validator) != nil
 		// if this valset has NOT been signed by operatorAddr, store it in pendingValsetReq
 		// and exit the loop
 		if !foundConfirm {
@@ -116,9 +123,11 @@ func (k Keeper) LastPendingBatchRequestByAddr(
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
+	ctx := sdk.UnwrapSDKContext(c)
+	validator := k.GetOrchestratorValidator(ctx, addr)
 	var pendingBatchReq *types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.OutgoingTxBatch) bool {
-		foundConfirm := k.GetBatchConfirm(sdk.UnwrapSDKContext(c), batch.BatchNonce, batch.TokenContract, addr) != nil
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+		foundConfirm := k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, validator) != nil
 		if !foundConfirm {
 			pendingBatchReq = batch
 			return true
@@ -137,10 +146,12 @@ func (k Keeper) LastPendingLogicCallByAddr(
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
+	ctx := sdk.UnwrapSDKContext(c)
+	validator := k.GetOrchestratorValidator(ctx, addr)
 	var pendingLogicReq *types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, logic *types.OutgoingLogicCall) bool {
-		foundConfirm := k.GetLogicCallConfirm(sdk.UnwrapSDKContext(c),
-			logic.InvalidationId, logic.InvalidationNonce, addr) != nil
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, logic *types.OutgoingLogicCall) bool {
+		foundConfirm := k.GetLogicCallConfirm(ctx,
+			logic.InvalidationId, logic.InvalidationNonce, validator) != nil
 		if !foundConfirm {
 			pendingLogicReq = logic
 			return true
@@ -316,7 +327,7 @@ func (k Keeper) GetDelegateKeyByEth(
 		return nil, sdkerrors.Wrap(err, "invalid eth address")
 	}
 	for _, key := range keys {
-		if req.EthAddress == key.EthAddress {
+		if normalizeEthAddress(req.EthAddress) == key.EthAddress {
 			return &types.QueryDelegateKeysByEthAddressResponse{
 				ValidatorAddress:    key.Validator,
 				OrchestratorAddress: key.Orchestrator,
@@ -330,25 +341,241 @@ func (k Keeper) GetDelegateKeyByEth(
 func (k Keeper) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
-	batches := k.GetOutgoingTxBatches(ctx)
-	unbatchedTx := k.GetPoolTransactions(ctx)
-	senderAddress := req.SenderAddress
-	var res *types.QueryPendingSendToEthResponse
-
-	for _, batch := range batches {
-		for _, tx := range batch.Transactions {
-			if tx.Sender == senderAddress {
-				res.TransfersInBatches = append(res.TransfersInBatches, tx)
-			}
-		}
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender address")
 	}
+	return k.getPendingSendToEth(sdk.UnwrapSDKContext(c), sender), nil
+}
 
-	for _, tx := range unbatchedTx {
-		if tx.Sender == senderAddress {
+// getPendingSendToEth splits the transfers of a sender that have not been executed on
+// Ethereum yet into the ones in batches and the ones still in the pool
+func (k Keeper) getPendingSendToEth(ctx sdk.Context, sender sdk.AccAddress) *types.QueryPendingSendToEthResponse {
+	res := &types.QueryPendingSendToEthResponse{}
+	k.IterateOutgoingPoolBySender(ctx, sender, func(tx *types.OutgoingTransferTx, inBatch bool) bool {
+		if inBatch {
+			res.TransfersInBatches = append(res.TransfersInBatches, tx)
+		} else {
 			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx)
 		}
-	}
+		return false
+	})
+	return res
+}
+
+// BridgeHijackIncidents queries the bridge hijack incidents and whether the bridge is halted
+func (k Keeper) BridgeHijackIncidents(
+	c context.Context,
+	req *types.QueryBridgeHijackIncidentsRequest) (*types.QueryBridgeHijackIncidentsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBridgeHijackIncidentsResponse{
+		Incidents:    k.GetBridgeHijackIncidents(ctx),
+		BridgeHalted: k.IsBridgeHalted(ctx),
+	}, nil
+}
+
+// BridgeStatus queries whether the bridge is halted or paused and the deposits queued while paused
+func (k Keeper) BridgeStatus(
+	c context.Context,
+	req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBridgeStatusResponse{
+		BridgeHalted:          k.IsBridgeHalted(ctx),
+		InboundDepositsPaused: k.IsInboundDepositsPaused(ctx),
+		OutboundSendsPaused:   k.IsOutboundSendsPaused(ctx),
+		BatchCreationPaused:   k.IsBatchCreationPaused(ctx),
+		QueuedDeposits:        k.GetQueuedDeposits(ctx),
+	}, nil
+}
 
+// OutboundRateLimitUsage queries the amount of a token sent to Ethereum within the current
+// outbound rate limit window and the limit that applies to it, next to the amount and limit
+// of all tokens together
+func (k Keeper) OutboundRateLimitUsage(
+	c context.Context,
+	req *types.QueryOutboundRateLimitUsageRequest) (*types.QueryOutboundRateLimitUsageResponse, error) {
+	if err := types.ValidateEthAddress(req.TokenContract); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "token contract invalid")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryOutboundRateLimitUsageResponse{
+		WindowUsage:       k.GetOutboundRateLimitUsage(ctx, req.TokenContract),
+		Window:            k.GetOutboundRateLimitWindow(ctx),
+		GlobalWindowUsage: k.GetOutboundGlobalRateLimitUsage(ctx),
+		GlobalWindowLimit: k.GetOutboundGlobalWindowLimit(ctx),
+	}
+	if limit, found := k.GetOutboundRateLimit(ctx, req.TokenContract); found {
+		res.Limit = &limit
+	}
 	return res, nil
 }
+
+// QueuedDepositsByReceiver queries the deposits to a Cosmos address that are not credited yet
+func (k Keeper) QueuedDepositsByReceiver(
+	c context.Context,
+	req *types.QueryQueuedDepositsByReceiverRequest) (*types.QueryQueuedDepositsByReceiverResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Receiver); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receiver invalid")
+	}
+	return &types.QueryQueuedDepositsByReceiverResponse{
+		Deposits: k.GetQueuedDepositsByReceiver(sdk.UnwrapSDKContext(c), req.Receiver),
+	}, nil
+}
+
+// ValidatorsMissingEthKeys queries the bonded validators without a registered Ethereum
+// address, which are left out of the valset
+func (k Keeper) ValidatorsMissingEthKeys(
+	c context.Context,
+	req *types.QueryValidatorsMissingEthKeysRequest) (*types.QueryValidatorsMissingEthKeysResponse, error) {
+	var validators []string
+	for _, val := range k.GetValidatorsMissingEthKeys(sdk.UnwrapSDKContext(c)) {
+		validators = append(validators, val.String())
+	}
+	return &types.QueryValidatorsMissingEthKeysResponse{Validators: validators}, nil
+}
+
+// ValsetSignatures queries a valset together with the signatures needed to relay it to
+// Ethereum, ordered like the members of the valset the contract holds
+func (k Keeper) ValsetSignatures(
+	c context.Context,
+	req *types.QueryValsetSignaturesRequest) (*types.QueryValsetSignaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valset := k.GetValset(ctx, req.Nonce)
+	if valset == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "can not find valset")
+	}
+	return &types.QueryValsetSignaturesResponse{
+		Valset:     valset,
+		Signatures: k.GetValsetSignatures(ctx, req.Nonce),
+	}, nil
+}
+
+// BatchSignatures queries a batch together with the signatures needed to relay it to
+// Ethereum, ordered like the members of the valset the contract holds
+func (k Keeper) BatchSignatures(
+	c context.Context,
+	req *types.QueryBatchSignaturesRequest) (*types.QueryBatchSignaturesResponse, error) {
+	if err := types.ValidateEthAddress(req.TokenContract); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "token contract invalid")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	batch := k.GetOutgoingTXBatch(ctx, req.TokenContract, req.Nonce)
+	if batch == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "can not find tx batch")
+	}
+	return &types.QueryBatchSignaturesResponse{
+		Batch:      batch,
+		Signatures: k.GetBatchSignatures(ctx, batch.TokenContract, batch.BatchNonce),
+	}, nil
+}
+
+// LogicCallSignatures queries a logic call together with the signatures needed to relay
+// it to Ethereum, ordered like the members of the valset the contract holds
+func (k Keeper) LogicCallSignatures(
+	c context.Context,
+	req *types.QueryLogicCallSignaturesRequest) (*types.QueryLogicCallSignaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	call := k.GetOutgoingLogicCall(ctx, req.InvalidationId, req.InvalidationNonce)
+	if len(call.InvalidationId) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "can not find logic call")
+	}
+	return &types.QueryLogicCallSignaturesResponse{
+		LogicCall:  call,
+		Signatures: k.GetLogicCallSignatures(ctx, call.InvalidationId, call.InvalidationNonce),
+	}, nil
+}
+
+// ValsetAtEthereumHeight queries the archived valset that was active on the Gravity.sol
+// contract at an Ethereum block height
+func (k Keeper) ValsetAtEthereumHeight(
+	c context.Context,
+	req *types.QueryValsetAtEthereumHeightRequest) (*types.QueryValsetAtEthereumHeightResponse, error) {
+	return &types.QueryValsetAtEthereumHeightResponse{
+		ObservedValset: k.GetObservedValsetAtEthereumHeight(sdk.UnwrapSDKContext(c), req.Height),
+	}, nil
+}
+
+// ValsetAtCosmosHeight queries the archived valset that had been observed as active on the
+// Gravity.sol contract at a Cosmos block height
+func (k Keeper) ValsetAtCosmosHeight(
+	c context.Context,
+	req *types.QueryValsetAtCosmosHeightRequest) (*types.QueryValsetAtCosmosHeightResponse, error) {
+	return &types.QueryValsetAtCosmosHeightResponse{
+		ObservedValset: k.GetObservedValsetAtCosmosHeight(sdk.UnwrapSDKContext(c), req.Height),
+	}, nil
+}
+
+// DelegateKeysNonce queries the nonce the next MsgSetOrchestratorAddress of a validator has to
+// be signed with
+func (k Keeper) DelegateKeysNonce(
+	c context.Context,
+	req *types.QueryDelegateKeysNonceRequest) (*types.QueryDelegateKeysNonceResponse, error) {
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator address")
+	}
+	return &types.QueryDelegateKeysNonceResponse{
+		Nonce: k.GetDelegateKeysNonce(sdk.UnwrapSDKContext(c), val),
+	}, nil
+}
+
+// OrchestratorsByValidator queries all the orchestrator keys a validator has delegated to
+func (k Keeper) OrchestratorsByValidator(
+	c context.Context,
+	req *types.QueryOrchestratorsByValidatorRequest) (*types.QueryOrchestratorsByValidatorResponse, error) {
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator address")
+	}
+	var orchestrators []string
+	for _, orch := range k.GetOrchestratorAddresses(sdk.UnwrapSDKContext(c), val) {
+		orchestrators = append(orchestrators, orch.String())
+	}
+	return &types.QueryOrchestratorsByValidatorResponse{OrchestratorAddresses: orchestrators}, nil
+}
+
+// PendingTransfersBySender queries the transfers of a sender that have not been executed on
+// Ethereum yet, including the ones in batches
+func (k Keeper) PendingTransfersBySender(
+	c context.Context,
+	req *types.QueryPendingTransfersBySenderRequest) (*types.QueryPendingTransfersBySenderResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender address")
+	}
+	transfers, pageRes, err := k.paginatePendingTransfers(sdk.UnwrapSDKContext(c), types.GetOutgoingTxBySenderPrefix(sender), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingTransfersBySenderResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// PendingTransfersByReceiver queries the transfers to an Ethereum address that have not been
+// executed on Ethereum yet, including the ones in batches
+func (k Keeper) PendingTransfersByReceiver(
+	c context.Context,
+	req *types.QueryPendingTransfersByReceiverRequest) (*types.QueryPendingTransfersByReceiverResponse, error) {
+	if err := types.ValidateEthAddress(req.DestAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid destination address")
+	}
+	transfers, pageRes, err := k.paginatePendingTransfers(sdk.UnwrapSDKContext(c), types.GetOutgoingTxByReceiverPrefix(req.DestAddress), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingTransfersByReceiverResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// paginatePendingTransfers pages through the sender or receiver index of the pool
+func (k Keeper) paginatePendingTransfers(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.PendingTransfer, *query.PageResponse, error) {
+	var transfers []types.PendingTransfer
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(prefixStore, pageReq, func(key []byte, _ []byte) error {
+		tx, inBatch := k.getIndexedPoolEntry(ctx, key)
+		transfers = append(transfers, types.PendingTransfer{Transfer: tx, InBatch: inBatch})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return transfers, pageRes, nil
+}
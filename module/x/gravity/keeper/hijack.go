@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// checkValsetUpdatedClaim compares an observed valset update on Ethereum against the
+// valset we stored for that nonce. If the Ethereum contract holds a validator set that this
+// chain never produced then somebody has taken control of the bridge, in that case an
+// incident is recorded and the bridge is halted until governance clears it.
+// Valsets that have already been pruned can't be checked and are skipped.
+func (k Keeper) checkValsetUpdatedClaim(ctx sdk.Context, claim *types.MsgValsetUpdatedClaim) {
+	// nonce 0 is the valset the contract was deployed with
+	if claim.ValsetNonce == 0 {
+		return
+	}
+
+	var expected []*types.BridgeValidator
+	stored := k.GetValset(ctx, claim.ValsetNonce)
+	switch {
+	case stored != nil:
+		expected = stored.WithoutEmptyMembers().Members
+		if types.BridgeValidators(expected).Equal(claim.Members) {
+			return
+		}
+	case claim.ValsetNonce <= k.GetLatestValsetNonce(ctx):
+		// pruned
+		return
+	}
+
+	incident := types.BridgeHijackIncident{
+		ValsetNonce:         claim.ValsetNonce,
+		ClaimedMembers:      claim.Members,
+		ExpectedMembers:     expected,
+		EventNonce:          claim.EventNonce,
+		EthereumBlockHeight: claim.BlockHeight,
+		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+	}
+	k.SetBridgeHijackIncident(ctx, incident)
+	k.setBridgeHalted(ctx, true)
+
+	k.logger(ctx).Error("bridge hijack detected",
+		"valset_nonce", claim.ValsetNonce,
+		"event_nonce", claim.EventNonce,
+	)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeHijack,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(claim.ValsetNonce)),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.EventNonce)),
+	))
+}
+
+// IsBridgeHalted returns true if outgoing batches and logic calls are halted after a bridge hijack
+func (k Keeper) IsBridgeHalted(ctx sdk.Context) bool {
+	var halted bool
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyBridgeHalted, &halted)
+	return halted
+}
+
+// setBridgeHalted halts or resumes outgoing batches and logic calls, once halted the bridge
+// can only be resumed by governance changing the param
+func (k Keeper) setBridgeHalted(ctx sdk.Context, halted bool) {
+	k.paramSpace.Set(ctx, types.ParamsStoreKeyBridgeHalted, halted)
+}
+
+// SetBridgeHijackIncident stores a bridge hijack incident by its event nonce
+func (k Keeper) SetBridgeHijackIncident(ctx sdk.Context, incident types.BridgeHijackIncident) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBridgeHijackIncidentKey(incident.EventNonce), k.cdc.MustMarshalBinaryBare(&incident))
+}
+
+// IterateBridgeHijackIncidents iterates through all bridge hijack incidents in event nonce ASC order
+func (k Keeper) IterateBridgeHijackIncidents(ctx sdk.Context, cb func([]byte, types.BridgeHijackIncident) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BridgeHijackIncidentKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var incident types.BridgeHijackIncident
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &incident)
+		// cb returns true to stop early
+		if cb(iter.Key(), incident) {
+			break
+		}
+	}
+}
+
+// GetBridgeHijackIncidents returns all bridge hijack incidents
+func (k Keeper) GetBridgeHijackIncidents(ctx sdk.Context) (out []types.BridgeHijackIncident) {
+	k.IterateBridgeHijackIncidents(ctx, func(_ []byte, incident types.BridgeHijackIncident) bool {
+		out = append(out, incident)
+		return false
+	})
+	return
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestValsetUpdatedClaimMatchingStore(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	handler := AttestationHandler{keeper: k, bankKeeper: input.BankKeeper}
+
+	valset := k.SetValsetRequest(ctx)
+	members := make([]*types.BridgeValidator, len(valset.Members))
+	// the order on Ethereum doesn't matter
+	for i, m := range valset.Members {
+		members[len(members)-1-i] = m
+	}
+	claim := &types.MsgValsetUpdatedClaim{EventNonce: 1, ValsetNonce: valset.Nonce, BlockHeight: 100, Members: members}
+	require.NoError(t, handler.Handle(ctx, types.Attestation{}, claim))
+
+	require.False(t, k.IsBridgeHalted(ctx))
+	require.Empty(t, k.GetBridgeHijackIncidents(ctx))
+	require.Equal(t, valset.Nonce, k.GetLastObservedValset(ctx).Nonce)
+}
+
+func TestValsetUpdatedClaimHijack(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	handler := AttestationHandler{keeper: k, bankKeeper: input.BankKeeper}
+
+	valset := k.SetValsetRequest(ctx)
+	hijacked := []*types.BridgeValidator{{Power: 4294967295, EthereumAddress: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"}}
+	claim := &types.MsgValsetUpdatedClaim{EventNonce: 1, ValsetNonce: valset.Nonce, BlockHeight: 100, Members: hijacked}
+	require.NoError(t, handler.Handle(ctx, types.Attestation{}, claim))
+
+	require.True(t, k.IsBridgeHalted(ctx))
+	incidents := k.GetBridgeHijackIncidents(ctx)
+	require.Len(t, incidents, 1)
+	require.Equal(t, types.BridgeHijackIncident{
+		ValsetNonce:         valset.Nonce,
+		ClaimedMembers:      hijacked,
+		ExpectedMembers:     valset.Members,
+		EventNonce:          1,
+		EthereumBlockHeight: 100,
+		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+	}, incidents[0])
+
+	var found bool
+	for _, e := range ctx.EventManager().Events() {
+		found = found || e.Type == types.EventTypeBridgeHijack
+	}
+	require.True(t, found)
+
+	// a valset nonce we never created is a hijack as well
+	claim = &types.MsgValsetUpdatedClaim{EventNonce: 2, ValsetNonce: valset.Nonce + 1, BlockHeight: 101, Members: hijacked}
+	require.NoError(t, handler.Handle(ctx, types.Attestation{}, claim))
+	incidents = k.GetBridgeHijackIncidents(ctx)
+	require.Len(t, incidents, 2)
+	require.Empty(t, incidents[1].ExpectedMembers)
+
+	res, err := k.BridgeHijackIncidents(sdk.WrapSDKContext(ctx), &types.QueryBridgeHijackIncidentsRequest{})
+	require.NoError(t, err)
+	require.True(t, res.BridgeHalted)
+	require.Equal(t, incidents, res.Incidents)
+}
+
+func TestBridgeHaltedBlocksOutgoingTraffic(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender            = AccAddrs[0]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	amount := types.NewERC20Token(100, myTokenContractAddr).GravityCoin()
+	fee := types.NewERC20Token(1, myTokenContractAddr).GravityCoin()
+	_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+	require.NoError(t, err)
+
+	k.setBridgeHalted(ctx, true)
+	_, err = k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 10)
+	require.True(t, types.ErrBridgeHalted.Is(err))
+	call := &types.OutgoingLogicCall{InvalidationId: []byte("halted"), InvalidationNonce: 1}
+	require.True(t, types.ErrBridgeHalted.Is(k.CreateOutgoingLogicCall(ctx, call)))
+	require.Nil(t, k.GetOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce).InvalidationId)
+
+	// governance clears the halt
+	params := k.GetParams(ctx)
+	params.BridgeHalted = false
+	k.SetParams(ctx, params)
+	batch, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	require.NoError(t, k.CreateOutgoingLogicCall(ctx, call))
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValsetUpdateRequestedByStakingHooks(t *testing.T) {
+	t.Run("slashed", func(t *testing.T) {
+		input, ctx := SetupFiveValChain(t)
+		k := input.GravityKeeper
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		input.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.GetConsensusPower(), sdk.NewDecWithPrec(1, 2))
+		require.Equal(t, uint64(ctx.BlockHeight()), k.GetValsetUpdateRequestHeight(ctx))
+	})
+
+	t.Run("bonded", func(t *testing.T) {
+		input := CreateTestEnv(t)
+		ctx := input.Context
+		k := input.GravityKeeper
+		input.StakingKeeper.SetParams(ctx, TestingStakeParams)
+		sh := staking.NewHandler(input.StakingKeeper)
+		bond := func(ctx sdk.Context, i int) {
+			acc := input.AccountKeeper.NewAccount(ctx, authtypes.NewBaseAccount(AccAddrs[i], AccPubKeys[i], uint64(i), 0))
+			input.BankKeeper.SetBalances(ctx, acc.GetAddress(), InitCoins)
+			input.AccountKeeper.SetAccount(ctx, acc)
+			_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[i], ConsPubKeys[i], StakingAmount))
+			require.NoError(t, err)
+			staking.EndBlocker(ctx, input.StakingKeeper)
+		}
+		for i := 0; i < 4; i++ {
+			bond(ctx, i)
+		}
+		require.Equal(t, uint64(ctx.BlockHeight()), k.GetValsetUpdateRequestHeight(ctx))
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		bond(ctx, 4)
+		require.Equal(t, uint64(ctx.BlockHeight()), k.GetValsetUpdateRequestHeight(ctx))
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		input, ctx := SetupFiveValChain(t)
+		k := input.GravityKeeper
+		sh := staking.NewHandler(input.StakingKeeper)
+		_, err := sh(ctx, NewTestMsgUnDelegateValidator(ValAddrs[0], StakingAmount))
+		require.NoError(t, err)
+		staking.EndBlocker(ctx, input.StakingKeeper)
+
+		// the validator is removed once its unbonding period has passed
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		staking.EndBlocker(ctx, input.StakingKeeper)
+		_, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+		require.False(t, found)
+		require.Equal(t, uint64(ctx.BlockHeight()), k.GetValsetUpdateRequestHeight(ctx))
+	})
+}
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
@@ -81,6 +82,18 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 		),
 	)
 
+	// let operators know which validators are missing from the valset
+	if missing := k.GetValidatorsMissingEthKeys(ctx); len(missing) > 0 {
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(valset.Nonce)),
+		}
+		for _, val := range missing {
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyValidator, val.String()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeValidatorsMissingEthKeys, attributes...))
+	}
+
 	return valset
 }
 
@@ -98,11 +111,14 @@ func (k Keeper) SetLatestValsetNonce(ctx sdk.Context, nonce uint64) {
 	store.Set(types.LatestValsetNonce, types.UInt64Bytes(nonce))
 }
 
-// StoreValsetUnsafe is for storing a valiator set at a given height
+// StoreValsetUnsafe is for storing a valiator set at a given height, it keeps the
+// height of the valset and only moves the latest valset nonce forward
 func (k Keeper) StoreValsetUnsafe(ctx sdk.Context, valset *types.Valset) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetValsetKey(valset.Nonce), k.cdc.MustMarshalBinaryBare(valset))
-	k.SetLatestValsetNonce(ctx, valset.Nonce)
+	if valset.Nonce > k.GetLatestValsetNonce(ctx) {
+		k.SetLatestValsetNonce(ctx, valset.Nonce)
+	}
 }
 
 // HasValsetRequest returns true if a valset defined by a nonce exists
@@ -205,22 +221,43 @@ func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedValsets returns all the unslashed validator sets in state
+// requestValsetUpdate marks that the power table changed in a way that needs a new valset,
+// the EndBlocker creates one for any request made after the latest valset
+func (k Keeper) requestValsetUpdate(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValsetUpdateRequestKey, types.UInt64Bytes(uint64(ctx.BlockHeight())))
+}
+
+// GetValsetUpdateRequestHeight returns the last block height at which a new valset was requested
+func (k Keeper) GetValsetUpdateRequestHeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.ValsetUpdateRequestKey)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// GetUnSlashedValsets returns all the unslashed validator sets in state that were
+// created below maxHeight
 func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, maxHeight uint64) (out []*types.Valset) {
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
-	k.IterateValsetBySlashedValsetNonce(ctx, lastSlashedValsetNonce, maxHeight, func(_ []byte, valset *types.Valset) bool {
-		if valset.Nonce > lastSlashedValsetNonce {
-			out = append(out, valset)
+	k.IterateValsetBySlashedValsetNonce(ctx, lastSlashedValsetNonce, func(_ []byte, valset *types.Valset) bool {
+		// valsets are created at increasing heights, so all later ones are too recent as well
+		if valset.Height >= maxHeight {
+			return true
 		}
+		out = append(out, valset)
 		return false
 	})
 	return
 }
 
-// IterateValsetBySlashedValsetNonce iterates through all valset by last slashed valset nonce in ASC order
-func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedValsetNonce uint64, maxHeight uint64, cb func([]byte, *types.Valset) bool) {
+// IterateValsetBySlashedValsetNonce iterates through all valsets after the last slashed valset nonce in ASC order
+func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedValsetNonce uint64, cb func([]byte, *types.Valset) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
-	iter := prefixStore.Iterator(types.UInt64Bytes(lastSlashedValsetNonce), types.UInt64Bytes(maxHeight))
+	iter := prefixStore.Iterator(types.UInt64Bytes(lastSlashedValsetNonce+1), nil)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
@@ -238,7 +275,7 @@ func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedVa
 /////////////////////////////
 
 // GetValsetConfirm returns a valset confirmation by a nonce and validator address
-func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.AccAddress) *types.MsgValsetConfirm {
+func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.ValAddress) *types.MsgValsetConfirm {
 	store := ctx.KVStore(k.storeKey)
 	entity := store.Get(types.GetValsetConfirmKey(nonce, validator))
 	if entity == nil {
@@ -249,14 +286,11 @@ func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.Ac
 	return &confirm
 }
 
-// SetValsetConfirm sets a valset confirmation
-func (k Keeper) SetValsetConfirm(ctx sdk.Context, valsetConf types.MsgValsetConfirm) []byte {
+// SetValsetConfirm sets a valset confirmation of a validator, whichever of its orchestrator
+// keys submitted it
+func (k Keeper) SetValsetConfirm(ctx sdk.Context, validator sdk.ValAddress, valsetConf types.MsgValsetConfirm) []byte {
 	store := ctx.KVStore(k.storeKey)
-	addr, err := sdk.AccAddressFromBech32(valsetConf.Orchestrator)
-	if err != nil {
-		panic(err)
-	}
-	key := types.GetValsetConfirmKey(valsetConf.Nonce, addr)
+	key := types.GetValsetConfirmKey(valsetConf.Nonce, validator)
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&valsetConf))
 	return key
 }
@@ -301,7 +335,7 @@ func (k Keeper) IterateValsetConfirmByNonce(ctx sdk.Context, nonce uint64, cb fu
 /////////////////////////////
 
 // GetBatchConfirm returns a batch confirmation given its nonce, the token contract, and a validator address
-func (k Keeper) GetBatchConfirm(ctx sdk.Context, nonce uint64, tokenContract string, validator sdk.AccAddress) *types.MsgConfirmBatch {
+func (k Keeper) GetBatchConfirm(ctx sdk.Context, nonce uint64, tokenContract string, validator sdk.ValAddress) *types.MsgConfirmBatch {
 	store := ctx.KVStore(k.storeKey)
 	entity := store.Get(types.GetBatchConfirmKey(tokenContract, nonce, validator))
 	if entity == nil {
@@ -312,14 +346,11 @@ func (k Keeper) GetBatchConfirm(ctx sdk.Context, nonce uint64, tokenContract str
 	return &confirm
 }
 
-// SetBatchConfirm sets a batch confirmation by a validator
-func (k Keeper) SetBatchConfirm(ctx sdk.Context, batch *types.MsgConfirmBatch) []byte {
+// SetBatchConfirm sets a batch confirmation by a validator, whichever of its orchestrator
+// keys submitted it
+func (k Keeper) SetBatchConfirm(ctx sdk.Context, validator sdk.ValAddress, batch *types.MsgConfirmBatch) []byte {
 	store := ctx.KVStore(k.storeKey)
-	acc, err := sdk.AccAddressFromBech32(batch.Orchestrator)
-	if err != nil {
-		panic(err)
-	}
-	key := types.GetBatchConfirmKey(batch.TokenContract, batch.Nonce, acc)
+	key := types.GetBatchConfirmKey(batch.TokenContract, batch.Nonce, validator)
 	store.Set(key, k.cdc.MustMarshalBinaryBare(batch))
 	return key
 }
@@ -356,10 +387,30 @@ func (k Keeper) GetBatchConfirmByNonceAndTokenContract(ctx sdk.Context, nonce ui
 //    ADDRESS DELEGATION   //
 /////////////////////////////
 
-// SetOrchestratorValidator sets the Orchestrator key for a given validator
+// SetOrchestratorValidator adds an Orchestrator key to a given validator
 func (k Keeper) SetOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, orch sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetOrchestratorAddressKey(orch), val.Bytes())
+	store.Set(types.GetOrchestratorsByValidatorKey(val, orch), []byte{})
+}
+
+// DeleteOrchestratorValidator removes an Orchestrator key from a given validator
+func (k Keeper) DeleteOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, orch sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOrchestratorAddressKey(orch))
+	store.Delete(types.GetOrchestratorsByValidatorKey(val, orch))
+}
+
+// GetOrchestratorAddresses returns all the Orchestrator keys of a given validator
+func (k Keeper) GetOrchestratorAddresses(ctx sdk.Context, val sdk.ValAddress) (out []sdk.AccAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(append([]byte{}, types.OrchestratorsByValidatorKey...), val.Bytes()...))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, sdk.AccAddress(iter.Key()))
+	}
+	return
 }
 
 // GetOrchestratorValidator returns the validator key associated with an orchestrator key
@@ -368,23 +419,72 @@ func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) s
 	return sdk.ValAddress(store.Get(types.GetOrchestratorAddressKey(orch)))
 }
 
+// GetDelegateKeysNonce returns the nonce the next MsgSetOrchestratorAddress of a validator has
+// to be signed with, which is the number of times the validator has set its delegate keys
+func (k Keeper) GetDelegateKeysNonce(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDelegateKeysNonceKey(val))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// setDelegateKeysNonce sets the delegate keys nonce of a validator
+func (k Keeper) setDelegateKeysNonce(ctx sdk.Context, val sdk.ValAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegateKeysNonceKey(val), types.UInt64Bytes(nonce))
+}
+
 /////////////////////////////
 //       ETH ADDRESS       //
 /////////////////////////////
 
-// SetEthAddress sets the ethereum address for a given validator
+// SetEthAddress sets the ethereum address for a given validator. If the address changes the
+// reverse index of the address it replaces is removed, and so is a rotation that is still in
+// its grace period. Messages only change an address through RotateEthAddressForValidator
 func (k Keeper) SetEthAddressForValidator(ctx sdk.Context, validator sdk.ValAddress, ethAddr string) {
+	ethAddr = normalizeEthAddress(ethAddr)
 	store := ctx.KVStore(k.storeKey)
+	if prev := k.GetEthAddressByValidator(ctx, validator); prev != "" && prev != ethAddr {
+		k.deleteValidatorByEthAddress(ctx, validator, prev)
+		if rotation := k.GetEthKeyRotation(ctx, validator); rotation != nil {
+			store.Delete(types.GetEthKeyRotationKey(validator))
+			k.deleteValidatorByEthAddress(ctx, validator, rotation.PreviousEthAddress)
+		}
+	}
 	store.Set(types.GetEthAddressByValidatorKey(validator), []byte(ethAddr))
 	store.Set(types.GetValidatorByEthAddressKey(ethAddr), []byte(validator))
 }
 
+// deleteValidatorByEthAddress removes the reverse index of an eth address if it still points
+// to the given validator
+func (k Keeper) deleteValidatorByEthAddress(ctx sdk.Context, validator sdk.ValAddress, ethAddr string) {
+	store := ctx.KVStore(k.storeKey)
+	if validator.Equals(sdk.ValAddress(store.Get(types.GetValidatorByEthAddressKey(ethAddr)))) {
+		store.Delete(types.GetValidatorByEthAddressKey(ethAddr))
+	}
+}
+
+// normalizeEthAddress returns the checksummed form of an eth address, so that an address is
+// stored the same way however it was cased in a message
+func normalizeEthAddress(ethAddr string) string {
+	return gethcommon.HexToAddress(ethAddr).Hex()
+}
+
 // GetEthAddressByValidator returns the eth address for a given gravity validator
 func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddress) string {
 	store := ctx.KVStore(k.storeKey)
 	return string(store.Get(types.GetEthAddressByValidatorKey(validator)))
 }
 
+// GetValidatorAddressByEthAddress returns the address of the validator an eth address is
+// indexed for, whether or not the validator still exists
+func (k Keeper) GetValidatorAddressByEthAddress(ctx sdk.Context, ethAddr string) sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.ValAddress(store.Get(types.GetValidatorByEthAddressKey(ethAddr)))
+}
+
 // GetValidatorByEthAddress returns the validator for a given eth address
 func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr string) (validator stakingtypes.Validator, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -406,30 +506,47 @@ func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr string) (valid
 // total voting power. This is an acceptable rounding error since floating
 // point may cause consensus problems if different floating point unit
 // implementations are involved.
+//
+// Validators without a registered Ethereum address can't sign for the bridge,
+// they are left out and only the power of the remaining validators is normalized
+// so that the valset can still reach the quorum on Ethereum.
 func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	bridgeValidators := make([]*types.BridgeValidator, len(validators))
+	bridgeValidators := make([]*types.BridgeValidator, 0, len(validators))
 	var totalPower uint64
 	// TODO someone with in depth info on Cosmos staking should determine
 	// if this is doing what I think it's doing
-	for i, validator := range validators {
+	for _, validator := range validators {
 		val := validator.GetOperator()
+		ethAddr := k.GetEthAddressByValidator(ctx, val)
+		if ethAddr == "" {
+			continue
+		}
 
 		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
 		totalPower += p
 
-		bridgeValidators[i] = &types.BridgeValidator{Power: p}
-		if ethAddr := k.GetEthAddressByValidator(ctx, val); ethAddr != "" {
-			bridgeValidators[i].EthereumAddress = ethAddr
-		}
+		bridgeValidators = append(bridgeValidators, &types.BridgeValidator{Power: p, EthereumAddress: ethAddr})
 	}
 	// normalize power values
 	for i := range bridgeValidators {
 		bridgeValidators[i].Power = sdk.NewUint(bridgeValidators[i].Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64()
 	}
 
-	// TODO: make the nonce an incrementing one (i.e. fetch last nonce from state, increment, set here)
-	return types.NewValset(uint64(ctx.BlockHeight()), uint64(ctx.BlockHeight()), bridgeValidators)
+	// the current valset is the one that would be stored next, NewValset sorts the members
+	// in canonical order so that ties in power don't depend on the staking module's order
+	return types.NewValset(k.GetLatestValsetNonce(ctx)+1, uint64(ctx.BlockHeight()), bridgeValidators)
+}
+
+// GetValidatorsMissingEthKeys returns the bonded validators that have no Ethereum
+// address registered and are therefore left out of the current valset
+func (k Keeper) GetValidatorsMissingEthKeys(ctx sdk.Context) (out []sdk.ValAddress) {
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		if k.GetEthAddressByValidator(ctx, validator.GetOperator()) == "" {
+			out = append(out, validator.GetOperator())
+		}
+	}
+	return
 }
 
 /////////////////////////////
@@ -444,8 +561,9 @@ func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, inv
 	return &call
 }
 
-// SetOutogingLogicCall sets an outgoing logic call
-func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) {
+// setOutgoingLogicCall stores an outgoing logic call without any checks, other
+// modules have to go through CreateOutgoingLogicCall
+func (k Keeper) setOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) {
 	store := ctx.KVStore(k.storeKey)
 
 	// Store checkpoint to prove that this logic call actually happened
@@ -456,6 +574,18 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicC
 		k.cdc.MustMarshalBinaryBare(call))
 }
 
+// CreateOutgoingLogicCall stores a new outgoing logic call to be signed and relayed,
+// this is the entry point for other modules using the arbitrary logic API. The call
+// is stamped with the current block height, which starts its slashing window
+func (k Keeper) CreateOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) error {
+	if k.IsBridgeHalted(ctx) {
+		return types.ErrBridgeHalted
+	}
+	call.Block = uint64(ctx.BlockHeight())
+	k.setOutgoingLogicCall(ctx, call)
+	return nil
+}
+
 // DeleteOutgoingLogicCall deletes outgoing logic calls
 func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
 	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
@@ -509,24 +639,20 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 //       LOGICCONFIRMS     //
 /////////////////////////////
 
-// SetLogicCallConfirm sets a logic confirm in the store
-func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, msg *types.MsgConfirmLogicCall) {
+// SetLogicCallConfirm sets a logic confirm of a validator in the store, whichever of its
+// orchestrator keys submitted it
+func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, validator sdk.ValAddress, msg *types.MsgConfirmLogicCall) {
 	bytes, err := hex.DecodeString(msg.InvalidationId)
 	if err != nil {
 		panic(err)
 	}
 
-	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
-	if err != nil {
-		panic(err)
-	}
-
 	ctx.KVStore(k.storeKey).
-		Set(types.GetLogicConfirmKey(bytes, msg.InvalidationNonce, acc), k.cdc.MustMarshalBinaryBare(msg))
+		Set(types.GetLogicConfirmKey(bytes, msg.InvalidationNonce, validator), k.cdc.MustMarshalBinaryBare(msg))
 }
 
 // GetLogicCallConfirm gets a logic confirm from the store
-func (k Keeper) GetLogicCallConfirm(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64, val sdk.AccAddress) *types.MsgConfirmLogicCall {
+func (k Keeper) GetLogicCallConfirm(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64, val sdk.ValAddress) *types.MsgConfirmLogicCall {
 	store := ctx.KVStore(k.storeKey)
 	data := store.Get(types.GetLogicConfirmKey(invalidationId, invalidationNonce, val))
 	if data == nil {
@@ -542,7 +668,7 @@ func (k Keeper) DeleteLogicCallConfirm(
 	ctx sdk.Context,
 	invalidationID []byte,
 	invalidationNonce uint64,
-	val sdk.AccAddress) {
+	val sdk.ValAddress) {
 	ctx.KVStore(k.storeKey).Delete(types.GetLogicConfirmKey(invalidationID, invalidationNonce, val))
 }
 
@@ -575,6 +701,37 @@ func (k Keeper) GetLogicConfirmByInvalidationIDAndNonce(ctx sdk.Context, invalid
 	return
 }
 
+// SetLastSlashedLogicCallBlock sets the latest slashed logic call block height
+func (k Keeper) SetLastSlashedLogicCallBlock(ctx sdk.Context, blockHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSlashedLogicCallBlock, types.UInt64Bytes(blockHeight))
+}
+
+// GetLastSlashedLogicCallBlock returns the latest slashed logic call block
+func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastSlashedLogicCallBlock)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// GetUnSlashedLogicCalls returns all the unslashed logic calls created before maxHeight,
+// sorted by block height in ASC order
+func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out []*types.OutgoingLogicCall) {
+	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+		if call.Block > lastSlashedLogicCallBlock && call.Block < maxHeight {
+			out = append(out, call)
+		}
+		return false
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Block < out[j].Block })
+	return
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -604,6 +761,23 @@ func (k Keeper) GetBridgeChainID(ctx sdk.Context) uint64 {
 	return a
 }
 
+// GetMaxOrchestratorsPerValidator returns the maximum number of orchestrator keys a
+// validator can delegate to, the default if the param is not set yet, e.g. after an upgrade
+func (k Keeper) GetMaxOrchestratorsPerValidator(ctx sdk.Context) uint64 {
+	a := types.DefaultParams().MaxOrchestratorsPerValidator
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyMaxOrchestratorsPerValidator, &a)
+	return a
+}
+
+// GetAttestationVotesPowerThreshold returns the fraction of the total voting power
+// that has to vote for an attestation before it is observed, the default if the param
+// is not set yet, e.g. after an upgrade
+func (k Keeper) GetAttestationVotesPowerThreshold(ctx sdk.Context) sdk.Dec {
+	a := types.DefaultParams().AttestationVotesPowerThreshold
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyAttestationVotesPowerThreshold, &a)
+	return a
+}
+
 // GetGravityID returns the GravityID the GravityID is essentially a salt value
 // for bridge signatures, provided each chain running Gravity has a unique ID
 // it won't be possible to play back signatures from one bridge onto another
@@ -649,73 +823,44 @@ func (k Keeper) UnpackAttestationClaim(att *types.Attestation) (types.EthereumCl
 	}
 }
 
-// GetDelegateKeys iterates both the EthAddress and Orchestrator address indexes to produce
-// a vector of MsgSetOrchestratorAddress entires containing all the delgate keys for state
-// export / import. This may seem at first glance to be excessively complicated, why not combine
-// the EthAddress and Orchestrator address indexes and simply iterate one thing? The answer is that
-// even though we set the Eth and Orchestrator address in the same place we use them differently we
-// always go from Orchestrator address to Validator address and from validator address to Ethereum address
-// we want to keep looking up the validator address for various reasons, so a direct Orchestrator to Ethereum
-// address mapping will mean having to keep two of the same data around just to provide lookups.
-//
-// For the time being this will serve
+// GetDelegateKeys produces a vector of MsgSetOrchestratorAddress entries containing all the delegate
+// keys for state export / import, one for every orchestrator key of a validator. The Orchestrator
+// keys are looked up through the validator, so the two indexes can't disagree. A validator with an
+// Ethereum address but no orchestrator key can't be represented and is left out, it can only come
+// about by setting the Ethereum address directly, since the last orchestrator key can't be removed
 func (k Keeper) GetDelegateKeys(ctx sdk.Context) []*types.MsgSetOrchestratorAddress {
 	store := ctx.KVStore(k.storeKey)
 	prefix := []byte(types.EthAddressByValidatorKey)
 	iter := store.Iterator(prefixRange(prefix))
 	defer iter.Close()
 
-	ethAddresses := make(map[string]string)
+	var result []*types.MsgSetOrchestratorAddress
 
 	for ; iter.Valid(); iter.Next() {
 		// the 'key' contains both the prefix and the value, so we need
 		// to cut off the starting bytes, if you don't do this a valid
 		// cosmos key will be made out of EthAddressByValidatorKey + the startin bytes
 		// of the actual key
-		key := iter.Key()[len(types.EthAddressByValidatorKey):]
-		value := iter.Value()
-		ethAddress := string(value)
-		valAddress := sdk.ValAddress(key)
-		ethAddresses[valAddress.String()] = ethAddress
-	}
-
-	store = ctx.KVStore(k.storeKey)
-	prefix = []byte(types.KeyOrchestratorAddress)
-	iter = store.Iterator(prefixRange(prefix))
-	defer iter.Close()
-
-	orchAddresses := make(map[string]string)
-
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()[len(types.KeyOrchestratorAddress):]
-		value := iter.Value()
-		orchAddress := sdk.AccAddress(key).String()
-		valAddress := sdk.ValAddress(value)
-		orchAddresses[valAddress.String()] = orchAddress
-	}
-
-	var result []*types.MsgSetOrchestratorAddress
-
-	for valAddr, ethAddr := range ethAddresses {
-		orch, ok := orchAddresses[valAddr]
-		if !ok {
-			// this should never happen unless the store
-			// is somehow inconsistent
-			panic("Can't find address")
+		valAddress := sdk.ValAddress(iter.Key()[len(types.EthAddressByValidatorKey):])
+		ethAddress := string(iter.Value())
+		nonce := k.GetDelegateKeysNonce(ctx, valAddress)
+		for _, orch := range k.GetOrchestratorAddresses(ctx, valAddress) {
+			result = append(result, &types.MsgSetOrchestratorAddress{
+				Orchestrator: orch.String(),
+				Validator:    valAddress.String(),
+				EthAddress:   ethAddress,
+				Nonce:        nonce,
+			})
 		}
-		result = append(result, &types.MsgSetOrchestratorAddress{
-			Orchestrator: orch,
-			Validator:    valAddr,
-			EthAddress:   ethAddr,
-		})
-
 	}
 
-	// we iterated over a map, so now we have to sort to ensure the
-	// output here is deterministic, eth address chosen for no particular
-	// reason
+	// sort to keep the output deterministic, eth address chosen for no particular
+	// reason and the orchestrator to order the keys of a validator
 	sort.Slice(result[:], func(i, j int) bool {
-		return result[i].EthAddress < result[j].EthAddress
+		if result[i].EthAddress != result[j].EthAddress {
+			return result[i].EthAddress < result[j].EthAddress
+		}
+		return result[i].Orchestrator < result[j].Orchestrator
 	})
 
 	return result
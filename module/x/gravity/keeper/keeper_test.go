@@ -47,8 +47,10 @@ func TestPrefixRange(t *testing.T) {
 
 func TestCurrentValsetNormalization(t *testing.T) {
 	specs := map[string]struct {
-		srcPowers []uint64
-		expPowers []uint64
+		srcPowers  []uint64
+		noEthKeys  map[int]bool
+		expPowers  []uint64
+		expMissing int
 	}{
 		"one": {
 			srcPowers: []uint64{100},
@@ -58,23 +60,33 @@ func TestCurrentValsetNormalization(t *testing.T) {
 			srcPowers: []uint64{100, 1},
 			expPowers: []uint64{4252442866, 42524428},
 		},
+		"without eth key": {
+			srcPowers:  []uint64{100, 50, 1},
+			noEthKeys:  map[int]bool{1: true},
+			expPowers:  []uint64{4252442866, 42524428},
+			expMissing: 1,
+		},
 	}
-	input := CreateTestEnv(t)
-	ctx := input.Context
 	for msg, spec := range specs {
 		spec := spec
 		t.Run(msg, func(t *testing.T) {
+			input := CreateTestEnv(t)
+			ctx := input.Context
 			operators := make([]MockStakingValidatorData, len(spec.srcPowers))
 			for i, v := range spec.srcPowers {
 				operators[i] = MockStakingValidatorData{
 					// any unique addr
-					Operator: bytes.Repeat([]byte{byte(i)}, sdk.AddrLen),
+					Operator: bytes.Repeat([]byte{byte(i + 1)}, sdk.AddrLen),
 					Power:    int64(v),
 				}
+				if !spec.noEthKeys[i] {
+					input.GravityKeeper.SetEthAddressForValidator(ctx, operators[i].Operator, EthAddrs[i].String())
+				}
 			}
 			input.GravityKeeper.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
 			r := input.GravityKeeper.GetCurrentValset(ctx)
 			assert.Equal(t, spec.expPowers, types.BridgeValidators(r.Members).GetPowers())
+			assert.Len(t, input.GravityKeeper.GetValidatorsMissingEthKeys(ctx), spec.expMissing)
 		})
 	}
 }
@@ -204,3 +216,48 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	assert.Equal(t, len(unslashedValsets), 6)
 	fmt.Println("unslashedValsetsRange", unslashedValsets)
 }
+
+func TestValsetNonceIncrements(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// valset nonces count up by one no matter the block height
+	require.Equal(t, uint64(1), k.GetCurrentValset(ctx).Nonce)
+	first := k.SetValsetRequest(ctx)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	second := k.SetValsetRequest(ctx)
+	assert.Equal(t, uint64(1), first.Nonce)
+	assert.Equal(t, uint64(2), second.Nonce)
+	assert.Equal(t, uint64(2), k.GetLatestValsetNonce(ctx))
+	assert.Equal(t, uint64(ctx.BlockHeight()), k.GetValset(ctx, 2).Height)
+
+	// slashing picks valsets by the height they were created at
+	unslashed := k.GetUnSlashedValsets(ctx, uint64(ctx.BlockHeight()))
+	require.Len(t, unslashed, 1)
+	assert.Equal(t, first.Nonce, unslashed[0].Nonce)
+	k.SetLastSlashedValsetNonce(ctx, first.Nonce)
+	unslashed = k.GetUnSlashedValsets(ctx, uint64(ctx.BlockHeight())+1)
+	require.Len(t, unslashed, 1)
+	assert.Equal(t, second.Nonce, unslashed[0].Nonce)
+}
+
+func TestValsetGenesisMigration(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context.WithBlockHeight(10)
+
+	// valsets of a chain that used block heights as nonces
+	genesis := ExportGenesis(ctx, k)
+	members := types.BridgeValidators{{Power: 1, EthereumAddress: EthAddrs[0].String()}}
+	genesis.Valsets = []*types.Valset{
+		types.NewValset(1000, 1000, members),
+		types.NewValset(1200, 0, members),
+	}
+	InitGenesis(ctx, k, genesis)
+
+	assert.Equal(t, uint64(1200), k.GetLatestValsetNonce(ctx))
+	for _, vs := range k.GetValsets(ctx) {
+		assert.Equal(t, uint64(ctx.BlockHeight()), vs.Height)
+	}
+	assert.Equal(t, uint64(1201), k.GetCurrentValset(ctx).Nonce)
+}
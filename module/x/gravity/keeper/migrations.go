@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// MigrateStore brings the store of a chain upgraded in place from the previous version of the
+// module up to date, it is run by the software upgrade handler of the app. Every step leaves
+// state that is already up to date untouched
+func (k Keeper) MigrateStore(ctx sdk.Context) {
+	k.migrateParams(ctx)
+	k.migrateLastSlashedAttestationNonce(ctx)
+	k.migrateValidatorsByEthAddress(ctx)
+	k.migrateConfirms(ctx)
+	k.migratePoolIndexes(ctx)
+}
+
+// migrateParams sets the params added since the previous version to their defaults, GetParams
+// panics as long as any of them is missing
+func (k Keeper) migrateParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// migrateLastSlashedAttestationNonce starts claim slashing after the last observed event nonce,
+// so that the events observed before the upgrade aren't slashed in retrospect
+func (k Keeper) migrateLastSlashedAttestationNonce(ctx sdk.Context) {
+	if ctx.KVStore(k.storeKey).Has(types.LastSlashedAttestationNonce) {
+		return
+	}
+	k.SetLastSlashedAttestationNonce(ctx, k.GetLastObservedEventNonce(ctx))
+}
+
+// migrateConfirms rekeys the valset, batch and logic call confirms from the orchestrator address
+// that submitted them to the address of its validator, slashing looks them up by validator. A
+// confirm that can't be attributed to a validator anymore is left as it is
+func (k Keeper) migrateConfirms(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	rekey := func(keyPrefix []byte, newKey func(value []byte) []byte) {
+		var oldKeys, newKeys, values [][]byte
+		prefixStore := prefix.NewStore(store, keyPrefix)
+		iter := prefixStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			key := append(append([]byte{}, keyPrefix...), iter.Key()...)
+			if to := newKey(iter.Value()); to != nil && !bytes.Equal(key, to) {
+				oldKeys = append(oldKeys, key)
+				newKeys = append(newKeys, to)
+				values = append(values, iter.Value())
+			}
+		}
+		iter.Close()
+		for i := range oldKeys {
+			store.Delete(oldKeys[i])
+			store.Set(newKeys[i], values[i])
+		}
+	}
+
+	rekey(types.ValsetConfirmKey, func(value []byte) []byte {
+		var confirm types.MsgValsetConfirm
+		k.cdc.MustUnmarshalBinaryBare(value, &confirm)
+		if val := k.getConfirmValidator(ctx, confirm.Orchestrator, confirm.EthAddress); val != nil {
+			return types.GetValsetConfirmKey(confirm.Nonce, val)
+		}
+		return nil
+	})
+	rekey(types.BatchConfirmKey, func(value []byte) []byte {
+		var confirm types.MsgConfirmBatch
+		k.cdc.MustUnmarshalBinaryBare(value, &confirm)
+		if val := k.getConfirmValidator(ctx, confirm.Orchestrator, confirm.EthSigner); val != nil {
+			return types.GetBatchConfirmKey(confirm.TokenContract, confirm.Nonce, val)
+		}
+		return nil
+	})
+	rekey(types.KeyOutgoingLogicConfirm, func(value []byte) []byte {
+		var confirm types.MsgConfirmLogicCall
+		k.cdc.MustUnmarshalBinaryBare(value, &confirm)
+		invalidationID, err := hex.DecodeString(confirm.InvalidationId)
+		if err != nil {
+			return nil
+		}
+		if val := k.getConfirmValidator(ctx, confirm.Orchestrator, confirm.EthSigner); val != nil {
+			return types.GetLogicConfirmKey(invalidationID, confirm.InvalidationNonce, val)
+		}
+		return nil
+	})
+}
+
+// migrateValidatorsByEthAddress lowercases the Ethereum addresses in the validator by Ethereum
+// address index, it used to be keyed by the address as it was submitted
+func (k Keeper) migrateValidatorsByEthAddress(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var ethAddresses []string
+	prefixStore := prefix.NewStore(store, types.ValidatorByEthAddressKey)
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if ethAddress := string(iter.Key()); ethAddress != strings.ToLower(ethAddress) {
+			ethAddresses = append(ethAddresses, ethAddress)
+		}
+	}
+	iter.Close()
+
+	for _, ethAddress := range ethAddresses {
+		val := prefixStore.Get([]byte(ethAddress))
+		prefixStore.Delete([]byte(ethAddress))
+		store.Set(types.GetValidatorByEthAddressKey(ethAddress), val)
+	}
+}
+
+// migratePoolIndexes indexes the transfers in the pool by sender and receiver, the indexes are
+// only written when a transfer is added. Transfers in batches are still in the pool, so they are
+// indexed as well
+func (k Keeper) migratePoolIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var txs []types.OutgoingTransferTx
+	prefixStore := prefix.NewStore(store, types.OutgoingTXPoolKey)
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var tx types.OutgoingTransferTx
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tx)
+		txs = append(txs, tx)
+	}
+	iter.Close()
+
+	for _, tx := range txs {
+		if sender, err := sdk.AccAddressFromBech32(tx.Sender); err == nil {
+			store.Set(types.GetOutgoingTxBySenderKey(sender, tx.Id), []byte{})
+		}
+		store.Set(types.GetOutgoingTxByReceiverKey(tx.DestAddress, tx.Id), []byte{})
+	}
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	// claims observed before the upgrade are not slashed in retrospect
+	k.setLastObservedEventNonce(ctx, 5)
+	k.MigrateStore(ctx)
+	assert.Equal(t, uint64(5), k.GetLastSlashedAttestationNonce(ctx))
+
+	// a migrated store is left untouched
+	k.setLastObservedEventNonce(ctx, 7)
+	k.MigrateStore(ctx)
+	assert.Equal(t, uint64(5), k.GetLastSlashedAttestationNonce(ctx))
+}
+
+func TestMigrateParams(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	// a chain upgraded from the previous version doesn't have the new params yet
+	paramStore := prefix.NewStore(ctx.KVStore(input.ParamsStoreKey), []byte(types.DefaultParamspace+"/"))
+	paramStore.Delete(types.ParamsStoreKeyAttestationVotesPowerThreshold)
+	paramStore.Delete(types.ParamsStoreKeyBatchSize)
+	assert.Equal(t, sdk.NewDecWithPrec(66, 2), k.GetAttestationVotesPowerThreshold(ctx))
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	k.MigrateStore(ctx)
+	params := k.GetParams(ctx)
+	assert.Equal(t, types.DefaultParams().AttestationVotesPowerThreshold, params.AttestationVotesPowerThreshold)
+	assert.Equal(t, types.DefaultParams().BatchSize, params.BatchSize)
+	// the params that were set are kept
+	assert.Equal(t, TestingGravityParams.GravityId, params.GravityId)
+}
+
+func TestMigrateConfirms(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	store := ctx.KVStore(input.GravityKeeper.storeKey)
+	orch := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	k.SetOrchestratorValidator(ctx, ValAddrs[0], orch)
+	invalidationID := []byte("invalidation")
+
+	// confirms used to be keyed by the orchestrator address that submitted them
+	valsetConfirm := types.MsgValsetConfirm{Nonce: 1, Orchestrator: orch.String(), EthAddress: EthAddrs[0].String()}
+	batchConfirm := types.MsgConfirmBatch{Nonce: 1, TokenContract: TokenContractAddrs[0], Orchestrator: orch.String(), EthSigner: EthAddrs[0].String()}
+	logicConfirm := types.MsgConfirmLogicCall{InvalidationId: hex.EncodeToString(invalidationID), InvalidationNonce: 1, Orchestrator: orch.String(), EthSigner: EthAddrs[0].String()}
+	store.Set(types.GetValsetConfirmKey(1, sdk.ValAddress(orch)), k.cdc.MustMarshalBinaryBare(&valsetConfirm))
+	store.Set(types.GetBatchConfirmKey(TokenContractAddrs[0], 1, sdk.ValAddress(orch)), k.cdc.MustMarshalBinaryBare(&batchConfirm))
+	store.Set(types.GetLogicConfirmKey(invalidationID, 1, sdk.ValAddress(orch)), k.cdc.MustMarshalBinaryBare(&logicConfirm))
+	// a validator that is its own orchestrator already has its confirms under the right key
+	ownConfirm := types.MsgValsetConfirm{Nonce: 1, Orchestrator: AccAddrs[1].String(), EthAddress: EthAddrs[1].String()}
+	k.SetValsetConfirm(ctx, ValAddrs[1], ownConfirm)
+	require.Nil(t, k.GetValsetConfirm(ctx, 1, ValAddrs[0]))
+
+	k.MigrateStore(ctx)
+	assert.Equal(t, &valsetConfirm, k.GetValsetConfirm(ctx, 1, ValAddrs[0]))
+	assert.Equal(t, &batchConfirm, k.GetBatchConfirm(ctx, 1, TokenContractAddrs[0], ValAddrs[0]))
+	assert.Equal(t, &logicConfirm, k.GetLogicCallConfirm(ctx, invalidationID, 1, ValAddrs[0]))
+	assert.Equal(t, &ownConfirm, k.GetValsetConfirm(ctx, 1, ValAddrs[1]))
+	assert.Len(t, k.GetValsetConfirms(ctx, 1), 2)
+	assert.Nil(t, k.GetBatchConfirm(ctx, 1, TokenContractAddrs[0], sdk.ValAddress(orch)))
+	assert.Nil(t, k.GetLogicCallConfirm(ctx, invalidationID, 1, sdk.ValAddress(orch)))
+}
+
+func TestMigrateValidatorsByEthAddress(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	store := ctx.KVStore(k.storeKey)
+
+	// the index used to be keyed by the checksummed address
+	ethAddress := "0x3E66C2b4e4bE9A64b5D5B9e91b9F3aA1E3D5a6e4"
+	store.Set(append(append([]byte{}, types.ValidatorByEthAddressKey...), []byte(ethAddress)...), ValAddrs[0])
+	require.Empty(t, k.GetValidatorAddressByEthAddress(ctx, ethAddress))
+
+	k.MigrateStore(ctx)
+	assert.Equal(t, ValAddrs[0], k.GetValidatorAddressByEthAddress(ctx, ethAddress))
+	assert.Equal(t, ValAddrs[0], k.GetValidatorAddressByEthAddress(ctx, strings.ToLower(ethAddress)))
+	assert.False(t, store.Has(append(append([]byte{}, types.ValidatorByEthAddressKey...), []byte(ethAddress)...)))
+}
+
+func TestMigratePoolIndexes(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	store := ctx.KVStore(k.storeKey)
+	var (
+		mySender            = AccAddrs[0]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	for i := uint64(1); i <= 2; i++ {
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver,
+			types.NewERC20Token(100, myTokenContractAddr).GravityCoin(), types.NewERC20Token(i, myTokenContractAddr).GravityCoin())
+		require.NoError(t, err)
+	}
+	_, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+	require.NoError(t, err)
+
+	// a chain upgraded from the previous version has a pool without the indexes
+	for _, indexPrefix := range [][]byte{types.OutgoingTxBySenderKey, types.OutgoingTxByReceiverKey} {
+		var keys [][]byte
+		iter := prefix.NewStore(store, indexPrefix).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, append(append([]byte{}, indexPrefix...), iter.Key()...))
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	indexed := func(iterate func(cb func(tx *types.OutgoingTransferTx, inBatch bool) bool)) (ids []uint64, inBatch []bool) {
+		iterate(func(tx *types.OutgoingTransferTx, batched bool) bool {
+			ids = append(ids, tx.Id)
+			inBatch = append(inBatch, batched)
+			return false
+		})
+		return ids, inBatch
+	}
+	ids, _ := indexed(func(cb func(*types.OutgoingTransferTx, bool) bool) { k.IterateOutgoingPoolBySender(ctx, mySender, cb) })
+	require.Empty(t, ids)
+
+	// the migration indexes every transfer, including the one in the batch
+	k.MigrateStore(ctx)
+	ids, inBatch := indexed(func(cb func(*types.OutgoingTransferTx, bool) bool) { k.IterateOutgoingPoolBySender(ctx, mySender, cb) })
+	assert.Equal(t, []uint64{1, 2}, ids)
+	assert.Equal(t, []bool{false, true}, inBatch)
+	ids, _ = indexed(func(cb func(*types.OutgoingTransferTx, bool) bool) {
+		k.IterateOutgoingPoolByReceiver(ctx, strings.ToLower(myReceiver), cb)
+	})
+	assert.Equal(t, []uint64{1, 2}, ids)
+}
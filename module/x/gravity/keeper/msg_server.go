@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -41,14 +42,43 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
 	}
 
-	// TODO consider impact of maliciously setting duplicate delegate
-	// addresses since no signatures from the private keys of these addresses
-	// are required for this message it could be sent in a hostile way.
+	// the orchestrator consents by co-signing the tx, the ethereum key by signing
+	// over the gravity id, validator, orchestrator and the nonce that keep the proof
+	// from being replayed
+	nonce := k.GetDelegateKeysNonce(ctx, val)
+	if msg.Nonce != nonce {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "nonce %d, expected %d", msg.Nonce, nonce)
+	}
+	sigBytes, err := hex.DecodeString(msg.EthSignature)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
+	}
+	hash := types.GetDelegateKeysHash(k.GetGravityID(ctx), val, orch, msg.Nonce)
+	if err = types.ValidateEthereumSignature(hash, sigBytes, msg.EthAddress); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "signature verification failed expected sig by %s", msg.EthAddress)
+	}
+
+	// an eth address is only changed with MsgRotateEthKey, which keeps the previous key in use
+	// until Gravity.sol has seen the new one
+	if current := k.GetEthAddressByValidator(ctx, val); current != "" && current != normalizeEthAddress(msg.EthAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "eth address already set to %s, change it with MsgRotateEthKey", current)
+	}
+	// delegate keys can't be shared with another validator
+	if owner := k.GetValidatorAddressByEthAddress(ctx, msg.EthAddress); len(owner) != 0 && !owner.Equals(val) {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "eth address %s already used by %s", msg.EthAddress, owner)
+	}
+	if owner := k.GetOrchestratorValidator(ctx, orch); len(owner) != 0 && !owner.Equals(val) {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "orchestrator address %s already used by %s", orch, owner)
+	}
 
-	// set the orchestrator address
+	// set the orchestrator address, it replaces all the orchestrator keys of the validator
+	for _, prev := range k.GetOrchestratorAddresses(ctx, val) {
+		k.DeleteOrchestratorValidator(ctx, val, prev)
+	}
 	k.SetOrchestratorValidator(ctx, val, orch)
 	// set the ethereum address
 	k.SetEthAddressForValidator(ctx, val, msg.EthAddress)
+	k.setDelegateKeysNonce(ctx, val, nonce+1)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -62,6 +92,145 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 
 }
 
+// RotateEthKey handles MsgRotateEthKey
+func (k msgServer) RotateEthKey(c context.Context, msg *types.MsgRotateEthKey) (*types.MsgRotateEthKeyResponse, error) {
+	// ensure that this passes validation
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	val, _ := sdk.ValAddressFromBech32(msg.Validator)
+
+	// ensure that the validator exists
+	if k.Keeper.StakingKeeper.Validator(ctx, val) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
+	}
+
+	previous := k.GetEthAddressByValidator(ctx, val)
+	if previous == "" {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address, set one with MsgSetOrchestratorAddress")
+	}
+	// the previous key of a rotation is only released once the contract has seen the new one
+	if k.GetEthKeyRotation(ctx, val) != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "previous eth key rotation still in its grace period")
+	}
+	if owner := k.GetValidatorAddressByEthAddress(ctx, msg.NewEthAddress); len(owner) != 0 {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "eth address %s already used by %s", msg.NewEthAddress, owner)
+	}
+
+	sigBytes, err := hex.DecodeString(msg.Signature)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
+	}
+	hash := types.GetEthKeyRotationHash(k.GetGravityID(ctx), val)
+	if err = types.ValidateEthereumSignature(hash, sigBytes, msg.NewEthAddress); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "signature verification failed expected sig by %s", msg.NewEthAddress)
+	}
+
+	// a validator outside the current valset and the one on Ethereum has no power the previous
+	// key has to keep signing for, so its rotation is completed right away
+	signing := k.isEthAddressSigning(ctx, previous)
+	k.RotateEthAddressForValidator(ctx, val, msg.NewEthAddress)
+	if signing {
+		// the new key only becomes usable on Ethereum with a valset that includes it
+		k.requestValsetUpdate(ctx)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEthKeyRotated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			sdk.NewAttribute(types.AttributeKeyEthAddress, msg.NewEthAddress),
+			sdk.NewAttribute(types.AttributeKeyPreviousEthAddress, previous),
+		),
+	)
+	if !signing {
+		k.completeEthKeyRotation(ctx, val, *k.GetEthKeyRotation(ctx, val))
+	}
+
+	return &types.MsgRotateEthKeyResponse{}, nil
+}
+
+// AddOrchestratorAddress handles MsgAddOrchestratorAddress
+func (k msgServer) AddOrchestratorAddress(c context.Context, msg *types.MsgAddOrchestratorAddress) (*types.MsgAddOrchestratorAddressResponse, error) {
+	// ensure that this passes validation
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	val, _ := sdk.ValAddressFromBech32(msg.Validator)
+	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+
+	// ensure that the validator exists
+	if k.Keeper.StakingKeeper.Validator(ctx, val) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
+	}
+	if k.GetEthAddressByValidator(ctx, val) == "" {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address, set one with MsgSetOrchestratorAddress")
+	}
+
+	// the orchestrator consents by co-signing the tx, it can't be shared with another validator
+	if owner := k.GetOrchestratorValidator(ctx, orch); len(owner) != 0 {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "orchestrator address %s already used by %s", orch, owner)
+	}
+	max := k.GetMaxOrchestratorsPerValidator(ctx)
+	if uint64(len(k.GetOrchestratorAddresses(ctx, val))) >= max {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "validator already has the maximum of %d orchestrator keys", max)
+	}
+
+	k.SetOrchestratorValidator(ctx, val, orch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			sdk.NewAttribute(types.AttributeKeySetOperatorAddr, orch.String()),
+		),
+	)
+
+	return &types.MsgAddOrchestratorAddressResponse{}, nil
+}
+
+// RemoveOrchestratorAddress handles MsgRemoveOrchestratorAddress
+func (k msgServer) RemoveOrchestratorAddress(c context.Context, msg *types.MsgRemoveOrchestratorAddress) (*types.MsgRemoveOrchestratorAddressResponse, error) {
+	// ensure that this passes validation
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	val, _ := sdk.ValAddressFromBech32(msg.Validator)
+	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+
+	if !k.GetOrchestratorValidator(ctx, orch).Equals(val) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "orchestrator address %s not used by %s", orch, val)
+	}
+	// claims and confirms need an orchestrator key, the last one can only be replaced
+	if len(k.GetOrchestratorAddresses(ctx, val)) <= 1 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "can't remove the last orchestrator key, replace it with MsgSetOrchestratorAddress")
+	}
+
+	k.DeleteOrchestratorValidator(ctx, val, orch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			sdk.NewAttribute(types.AttributeKeySetOperatorAddr, orch.String()),
+		),
+	)
+
+	return &types.MsgRemoveOrchestratorAddressResponse{}, nil
+}
+
 // ValsetConfirm handles MsgValsetConfirm
 // TODO: check msgValsetConfirm to have an Orchestrator field instead of a Validator field
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
@@ -85,20 +254,24 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
 	}
 
-	ethAddress := k.GetEthAddressByValidator(ctx, validator)
+	// during the grace period of a rotation only the previous key is known to Gravity.sol
+	ethAddress := k.getConfirmEthAddress(ctx, validator)
 	if ethAddress == "" {
 		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
 
-	if err = types.ValidateEthereumSignature(checkpoint, sigBytes, ethAddress); err != nil {
+	err = types.ValidateEthereumSignature(checkpoint, sigBytes, ethAddress)
+	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with gravity-id %s with checkpoint %s found %s", ethAddress, gravityID, hex.EncodeToString(checkpoint), msg.Signature))
 	}
+	// the confirm records the key that made the signature, relayers look signatures up by it
+	msg.EthAddress = ethAddress
 
-	// persist signature
-	if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
+	// persist signature, once per validator whichever of its orchestrator keys sends it
+	if k.GetValsetConfirm(ctx, msg.Nonce, validator) != nil {
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
 	}
-	key := k.SetValsetConfirm(ctx, *msg)
+	key := k.SetValsetConfirm(ctx, validator, *msg)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -145,10 +318,23 @@ func (k msgServer) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (
 		return nil, err
 	}
 
-	batch, err := k.BuildOutgoingTXBatch(ctx, tokenContract, OutgoingTxBatchSize)
+	// the sender may ask for a smaller batch than the batch size of the token
+	maxElements := k.GetBatchSize(ctx, tokenContract)
+	if msg.MaxBatchSize != 0 && msg.MaxBatchSize < uint64(maxElements) {
+		maxElements = int(msg.MaxBatchSize)
+	}
+
+	batch, err := k.BuildOutgoingTXBatch(ctx, tokenContract, maxElements)
 	if err != nil {
+		// unless the sender asked to fail, a batch below the threshold is simply not created
+		if !msg.Strict && errors.Is(err, types.ErrBatchBelowThreshold) {
+			return &types.MsgRequestBatchResponse{}, nil
+		}
 		return nil, err
 	}
+	if batch == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "no transactions to batch")
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -158,7 +344,7 @@ func (k msgServer) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (
 		),
 	)
 
-	return &types.MsgRequestBatchResponse{}, nil
+	return &types.MsgRequestBatchResponse{BatchNonce: batch.BatchNonce}, nil
 }
 
 // ConfirmBatch handles MsgConfirmBatch
@@ -185,7 +371,8 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
 	}
 
-	ethAddress := k.GetEthAddressByValidator(ctx, validator)
+	// during the grace period of a rotation only the previous key is known to Gravity.sol
+	ethAddress := k.getConfirmEthAddress(ctx, validator)
 	if ethAddress == "" {
 		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
@@ -194,12 +381,14 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with gravity-id %s with checkpoint %s found %s", ethAddress, gravityID, hex.EncodeToString(checkpoint), msg.Signature))
 	}
+	// the confirm records the key that made the signature, relayers look signatures up by it
+	msg.EthSigner = ethAddress
 
-	// check if we already have this confirm
-	if k.GetBatchConfirm(ctx, msg.Nonce, msg.TokenContract, orchaddr) != nil {
+	// check if we already have this confirm, once per validator whichever of its orchestrator keys sends it
+	if k.GetBatchConfirm(ctx, msg.Nonce, msg.TokenContract, validator) != nil {
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
 	}
-	key := k.SetBatchConfirm(ctx, msg)
+	key := k.SetBatchConfirm(ctx, validator, msg)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -240,7 +429,8 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
 	}
 
-	ethAddress := k.GetEthAddressByValidator(ctx, validator)
+	// during the grace period of a rotation only the previous key is known to Gravity.sol
+	ethAddress := k.getConfirmEthAddress(ctx, validator)
 	if ethAddress == "" {
 		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
@@ -249,13 +439,15 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with gravity-id %s with checkpoint %s found %s", ethAddress, gravityID, hex.EncodeToString(checkpoint), msg.Signature))
 	}
+	// the confirm records the key that made the signature, relayers look signatures up by it
+	msg.EthSigner = ethAddress
 
-	// check if we already have this confirm
-	if k.GetLogicCallConfirm(ctx, invalidationIdBytes, msg.InvalidationNonce, orchaddr) != nil {
+	// check if we already have this confirm, once per validator whichever of its orchestrator keys sends it
+	if k.GetLogicCallConfirm(ctx, invalidationIdBytes, msg.InvalidationNonce, validator) != nil {
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
 	}
 
-	k.SetLogicCallConfirm(ctx, msg)
+	k.SetLogicCallConfirm(ctx, validator, msg)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -268,9 +460,6 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 }
 
 // DepositClaim handles MsgDepositClaim
-// TODO it is possible to submit an old msgDepositClaim (old defined as covering an event nonce that has already been
-// executed aka 'observed' and had it's slashing window expire) that will never be cleaned up in the endblocker. This
-// should not be a security risk as 'old' events can never execute but it does store spam in the chain.
 func (k msgServer) DepositClaim(c context.Context, msg *types.MsgDepositClaim) (*types.MsgDepositClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -311,9 +500,6 @@ func (k msgServer) DepositClaim(c context.Context, msg *types.MsgDepositClaim) (
 }
 
 // WithdrawClaim handles MsgWithdrawClaim
-// TODO it is possible to submit an old msgWithdrawClaim (old defined as covering an event nonce that has already been
-// executed aka 'observed' and had it's slashing window expire) that will never be cleaned up in the endblocker. This
-// should not be a security risk as 'old' events can never execute but it does store spam in the chain.
 func (k msgServer) WithdrawClaim(c context.Context, msg *types.MsgWithdrawClaim) (*types.MsgWithdrawClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -495,6 +681,29 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 	return &types.MsgCancelSendToEthResponse{}, nil
 }
 
+// IncreaseBridgeFee handles MsgIncreaseBridgeFee
+func (k msgServer) IncreaseBridgeFee(c context.Context, msg *types.MsgIncreaseBridgeFee) (*types.MsgIncreaseBridgeFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.IncreaseBridgeFee(ctx, msg.TransactionId, sender, msg.AdditionalFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.TransactionId)),
+		),
+	)
+
+	return &types.MsgIncreaseBridgeFeeResponse{}, nil
+}
+
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// SetObservedValset archives a valset update observed on Ethereum. Unlike valset requests,
+// which are pruned once they can no longer be submitted, the archive keeps every observed
+// valset until it was replaced on Ethereum more than ObservedValsetsWindow blocks ago
+func (k Keeper) SetObservedValset(ctx sdk.Context, observed types.ObservedValset) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetObservedValsetKey(observed.EthereumBlockHeight, observed.Valset.Nonce)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&observed))
+}
+
+// IterateObservedValsets iterates through the observed valsets in the order they were
+// observed, which is Ethereum block height ASC order
+func (k Keeper) IterateObservedValsets(ctx sdk.Context, cb func([]byte, types.ObservedValset) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservedValsetKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var observed types.ObservedValset
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &observed)
+		// cb returns true to stop early
+		if cb(iter.Key(), observed) {
+			break
+		}
+	}
+}
+
+// GetObservedValsets returns all archived observed valsets
+func (k Keeper) GetObservedValsets(ctx sdk.Context) (out []types.ObservedValset) {
+	k.IterateObservedValsets(ctx, func(_ []byte, observed types.ObservedValset) bool {
+		out = append(out, observed)
+		return false
+	})
+	return
+}
+
+// GetObservedValsetAtEthereumHeight returns the valset that was active on the Gravity.sol
+// contract at the given Ethereum block height, which is the last valset update observed at
+// or before that height. Returns nil if there is no such valset in the archive
+func (k Keeper) GetObservedValsetAtEthereumHeight(ctx sdk.Context, ethereumHeight uint64) *types.ObservedValset {
+	var end []byte
+	if ethereumHeight != math.MaxUint64 {
+		end = types.UInt64Bytes(ethereumHeight + 1)
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservedValsetKey)
+	iter := prefixStore.ReverseIterator(nil, end)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+	var observed types.ObservedValset
+	k.cdc.MustUnmarshalBinaryBare(iter.Value(), &observed)
+	return &observed
+}
+
+// GetObservedValsetAtCosmosHeight returns the valset this chain had observed as active on the
+// Gravity.sol contract at the given Cosmos block height. Returns nil if no valset update had
+// been observed by then or it is no longer in the archive
+func (k Keeper) GetObservedValsetAtCosmosHeight(ctx sdk.Context, cosmosHeight uint64) *types.ObservedValset {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservedValsetKey)
+	iter := prefixStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	// events are observed in order, so the Cosmos heights increase with the Ethereum heights
+	for ; iter.Valid(); iter.Next() {
+		var observed types.ObservedValset
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &observed)
+		if observed.CosmosBlockHeight <= cosmosHeight {
+			return &observed
+		}
+	}
+	return nil
+}
+
+// PruneObservedValsets removes the observed valsets that were replaced on Ethereum more than
+// window blocks ago. The latest observed valset is never pruned, since it is still active
+func (k Keeper) PruneObservedValsets(ctx sdk.Context, window uint64) {
+	var (
+		prunable [][]byte
+		prevKey  []byte
+	)
+	currentHeight := uint64(ctx.BlockHeight())
+	k.IterateObservedValsets(ctx, func(key []byte, observed types.ObservedValset) bool {
+		// the previous valset stopped being active when this one was observed
+		if observed.CosmosBlockHeight+window >= currentHeight {
+			return true
+		}
+		if prevKey != nil {
+			prunable = append(prunable, prevKey)
+		}
+		prevKey = key
+		return false
+	})
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservedValsetKey)
+	for _, key := range prunable {
+		prefixStore.Delete(key)
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestObservedValsetArchive(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	handler := AttestationHandler{keeper: k, bankKeeper: input.BankKeeper}
+	start := ctx.BlockHeight()
+
+	// three valset updates observed at Ethereum heights 100, 200 and 300
+	for i := uint64(1); i <= 3; i++ {
+		ctx = ctx.WithBlockHeight(start + int64(i*10))
+		valset := k.SetValsetRequest(ctx)
+		claim := &types.MsgValsetUpdatedClaim{EventNonce: i, ValsetNonce: valset.Nonce, BlockHeight: i * 100, Members: valset.Members}
+		require.NoError(t, handler.Handle(ctx, types.Attestation{}, claim))
+	}
+	require.Len(t, k.GetObservedValsets(ctx), 3)
+
+	for _, tc := range []struct {
+		ethereumHeight uint64
+		expNonce       uint64
+	}{
+		{99, 0},
+		{100, 1},
+		{199, 1},
+		{200, 2},
+		{300, 3},
+		{1000000, 3},
+	} {
+		observed := k.GetObservedValsetAtEthereumHeight(ctx, tc.ethereumHeight)
+		if tc.expNonce == 0 {
+			assert.Nil(t, observed, "ethereum height %d", tc.ethereumHeight)
+			continue
+		}
+		require.NotNil(t, observed, "ethereum height %d", tc.ethereumHeight)
+		assert.Equal(t, tc.expNonce, observed.Valset.Nonce, "ethereum height %d", tc.ethereumHeight)
+	}
+
+	for _, tc := range []struct {
+		cosmosHeight int64
+		expNonce     uint64
+	}{
+		{start + 9, 0},
+		{start + 10, 1},
+		{start + 29, 2},
+		{start + 30, 3},
+	} {
+		res, err := k.ValsetAtCosmosHeight(sdk.WrapSDKContext(ctx), &types.QueryValsetAtCosmosHeightRequest{Height: uint64(tc.cosmosHeight)})
+		require.NoError(t, err)
+		if tc.expNonce == 0 {
+			assert.Nil(t, res.ObservedValset, "cosmos height %d", tc.cosmosHeight)
+			continue
+		}
+		require.NotNil(t, res.ObservedValset, "cosmos height %d", tc.cosmosHeight)
+		assert.Equal(t, tc.expNonce, res.ObservedValset.Valset.Nonce, "cosmos height %d", tc.cosmosHeight)
+		assert.Equal(t, tc.expNonce*100, res.ObservedValset.EthereumBlockHeight)
+	}
+
+	// pruning the valset requests leaves the archive alone
+	for _, vs := range k.GetValsets(ctx) {
+		k.DeleteValset(ctx, vs.Nonce)
+	}
+	require.Len(t, k.GetObservedValsets(ctx), 3)
+
+	// valsets are kept for the window after they were replaced, the active one is always kept
+	ctx = ctx.WithBlockHeight(start + 46)
+	k.PruneObservedValsets(ctx, 25)
+	observed := k.GetObservedValsets(ctx)
+	require.Len(t, observed, 2)
+	assert.Equal(t, uint64(2), observed[0].Valset.Nonce)
+	ctx = ctx.WithBlockHeight(start + 1000)
+	k.PruneObservedValsets(ctx, 25)
+	observed = k.GetObservedValsets(ctx)
+	require.Len(t, observed, 1)
+	assert.Equal(t, uint64(3), observed[0].Valset.Nonce)
+
+	// the archive survives an import and export
+	params := TestingGravityParams
+	fresh := CreateTestEnv(t)
+	InitGenesis(fresh.Context, fresh.GravityKeeper, types.GenesisState{Params: &params, ObservedValsets: observed})
+	require.Equal(t, observed, ExportGenesis(fresh.Context, fresh.GravityKeeper).ObservedValsets)
+}
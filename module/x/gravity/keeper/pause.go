@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// IsInboundDepositsPaused returns true if governance has paused deposits from Ethereum
+func (k Keeper) IsInboundDepositsPaused(ctx sdk.Context) bool {
+	var paused bool
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyInboundDepositsPaused, &paused)
+	return paused
+}
+
+// IsOutboundSendsPaused returns true if governance has paused sends to Ethereum
+func (k Keeper) IsOutboundSendsPaused(ctx sdk.Context) bool {
+	var paused bool
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyOutboundSendsPaused, &paused)
+	return paused
+}
+
+// IsBatchCreationPaused returns true if governance has paused the creation of outgoing batches
+func (k Keeper) IsBatchCreationPaused(ctx sdk.Context) bool {
+	var paused bool
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyBatchCreationPaused, &paused)
+	return paused
+}
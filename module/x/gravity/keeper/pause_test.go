@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestDepositsQueuedWhilePaused(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		myReceiver          = AccAddrs[0]
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		voucher             = types.NewERC20Token(100, myTokenContractAddr).GravityCoin()
+	)
+	balance := input.BankKeeper.GetBalance(ctx, myReceiver, voucher.Denom)
+
+	params := k.GetParams(ctx)
+	params.InboundDepositsPaused = true
+	k.SetParams(ctx, params)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		claim := &types.MsgDepositClaim{
+			EventNonce:     nonce,
+			TokenContract:  myTokenContractAddr,
+			Amount:         voucher.Amount,
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: myReceiver.String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+
+	// nothing is credited while paused
+	k.ReleaseQueuedDeposits(ctx)
+	require.Len(t, k.GetQueuedDeposits(ctx), 2)
+	require.Equal(t, balance, input.BankKeeper.GetBalance(ctx, myReceiver, voucher.Denom))
+
+	res, err := k.BridgeStatus(sdk.WrapSDKContext(ctx), &types.QueryBridgeStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, res.InboundDepositsPaused)
+	require.Len(t, res.QueuedDeposits, 2)
+
+	// once resumed the queue is credited
+	params.InboundDepositsPaused = false
+	k.SetParams(ctx, params)
+	k.ReleaseQueuedDeposits(ctx)
+	require.Empty(t, k.GetQueuedDeposits(ctx))
+	require.Equal(t, balance.Add(voucher).Add(voucher), input.BankKeeper.GetBalance(ctx, myReceiver, voucher.Denom))
+}
+
+func TestOutboundPaused(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender            = AccAddrs[0]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+		amount              = types.NewERC20Token(100, myTokenContractAddr).GravityCoin()
+		fee                 = types.NewERC20Token(1, myTokenContractAddr).GravityCoin()
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	txID, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.OutboundSendsPaused = true
+	params.BatchCreationPaused = true
+	k.SetParams(ctx, params)
+
+	_, err = k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+	require.True(t, types.ErrBridgePaused.Is(err))
+	_, err = k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 10)
+	require.True(t, types.ErrBridgePaused.Is(err))
+
+	// pending transfers can still be cancelled while paused
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, txID, mySender))
+
+	params.OutboundSendsPaused = false
+	params.BatchCreationPaused = false
+	k.SetParams(ctx, params)
+	_, err = k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+	require.NoError(t, err)
+	_, err = k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 10)
+	require.NoError(t, err)
+}
@@ -20,6 +20,9 @@ import (
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool via a second index
 func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	if k.IsOutboundSendsPaused(ctx) {
+		return 0, sdkerrors.Wrap(types.ErrBridgePaused, "outbound sends")
+	}
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
 
@@ -31,27 +34,17 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 		return 0, err
 	}
 
-	// If it is a cosmos-originated asset we lock it
-	if isCosmosOriginated {
-		// lock coins in module
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
-			return 0, err
-		}
-	} else {
-		// If it is an ethereum-originated asset we burn it
-		// send coins to module in prep for burn
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
-			return 0, err
-		}
+	if err := k.checkOutboundRateLimit(ctx, tokenContract, totalAmount.Amount); err != nil {
+		return 0, err
+	}
 
-		// burn vouchers to send them back to ETH
-		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, totalInVouchers); err != nil {
-			panic(err)
-		}
+	if err := k.takeOutgoingCoins(ctx, sender, isCosmosOriginated, totalInVouchers); err != nil {
+		return 0, err
 	}
 
 	// get next tx id from keeper
 	nextID := k.autoIncrementID(ctx, types.KeyLastTXPoolID)
+	k.useOutboundRateLimit(ctx, nextID, tokenContract, totalAmount.Amount)
 
 	erc20Fee := types.NewSDKIntERC20Token(fee.Amount, tokenContract)
 
@@ -64,9 +57,10 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 		DestAddress: counterpartReceiver,
 		Erc20Token:  types.NewSDKIntERC20Token(amount.Amount, tokenContract),
 		Erc20Fee:    erc20Fee,
+		Block:       uint64(ctx.BlockHeight()),
 	}
 
-	// set the outgoing tx in the pool index
+	// set the outgoing tx in the pool index, this also indexes it by sender and receiver
 	if err := k.setPoolEntry(ctx, outgoing); err != nil {
 		return 0, err
 	}
@@ -74,9 +68,6 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 	// add a second index with the fee
 	k.appendToUnbatchedTXIndex(ctx, tokenContract, *erc20Fee, nextID)
 
-	// todo: add second index for sender so that we can easily query: give pending Tx by sender
-	// todo: what about a second index for receiver?
-
 	poolEvent := sdk.NewEvent(
 		types.EventTypeBridgeWithdrawalReceived,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -90,6 +81,89 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 	return nextID, nil
 }
 
+// takeOutgoingCoins takes the coins of a transfer to Ethereum from the sender
+func (k Keeper) takeOutgoingCoins(ctx sdk.Context, sender sdk.AccAddress, isCosmosOriginated bool, coins sdk.Coins) error {
+	// If it is a cosmos-originated asset we lock it
+	if isCosmosOriginated {
+		// lock coins in module
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+			return err
+		}
+	} else {
+		// If it is an ethereum-originated asset we burn it
+		// send coins to module in prep for burn
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+			return err
+		}
+
+		// burn vouchers to send them back to ETH
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			panic(err)
+		}
+	}
+	return nil
+}
+
+// IncreaseBridgeFee
+// - checks that the tx exists, was sent by the sender and is not in a batch
+// - locks or burns the additional fee like AddToOutgoingPool
+// - moves the tx to its new fee in the `available` TX pool, behind the txs that already had that fee
+func (k Keeper) IncreaseBridgeFee(ctx sdk.Context, txId uint64, sender sdk.AccAddress, additionalFee sdk.Coin) error {
+	if k.IsOutboundSendsPaused(ctx) {
+		return sdkerrors.Wrap(types.ErrBridgePaused, "outbound sends")
+	}
+	tx, err := k.getPoolEntry(ctx, txId)
+	if err != nil {
+		return err
+	}
+
+	// only the sender can pay for its tx to be picked up sooner
+	if tx.Sender != sender.String() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "Sender %s did not send Id %d", sender, txId)
+	}
+	if !k.isUnbatched(ctx, *tx.Erc20Fee, txId) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "Id %d is in a batch", txId)
+	}
+
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, additionalFee.Denom)
+	if err != nil {
+		return err
+	}
+	if tokenContract != tx.Erc20Fee.Contract {
+		return sdkerrors.Wrapf(types.ErrInvalid, "fee denom %s does not match the token of Id %d", additionalFee.Denom, txId)
+	}
+
+	if err := k.checkOutboundRateLimit(ctx, tokenContract, additionalFee.Amount); err != nil {
+		return err
+	}
+	if err := k.takeOutgoingCoins(ctx, sender, isCosmosOriginated, sdk.Coins{additionalFee}); err != nil {
+		return err
+	}
+	k.useOutboundRateLimit(ctx, txId, tokenContract, additionalFee.Amount)
+
+	// re-index the tx under its new fee
+	if err := k.removeFromUnbatchedTXIndex(ctx, *tx.Erc20Fee, txId); err != nil {
+		return err
+	}
+	tx.Erc20Fee = types.NewSDKIntERC20Token(tx.Erc20Fee.Amount.Add(additionalFee.Amount), tokenContract)
+	if err := k.setPoolEntry(ctx, tx); err != nil {
+		return err
+	}
+	k.appendToUnbatchedTXIndex(ctx, tokenContract, *tx.Erc20Fee, txId)
+
+	poolEvent := sdk.NewEvent(
+		types.EventTypeBridgeFeeIncreased,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txId))),
+		sdk.NewAttribute(types.AttributeKeyBridgeFee, tx.Erc20Fee.Amount.String()),
+	)
+	ctx.EventManager().EmitEvent(poolEvent)
+
+	return nil
+}
+
 // RemoveFromOutgoingPoolAndRefund
 // - checks that the provided tx actually exists
 // - deletes the unbatched tx from the pool
@@ -111,14 +185,7 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		return sdkerrors.Wrapf(types.ErrInvalid, "Sender %s did not send Id %d", sender, txId)
 	}
 
-	found := false
-	poolTx := k.GetPoolTransactions(ctx)
-	for _, pTx := range poolTx {
-		if pTx.Id == txId {
-			found = true
-		}
-	}
-	if !found {
+	if !k.isUnbatched(ctx, *tx.Erc20Fee, txId) {
 		return sdkerrors.Wrapf(types.ErrInvalid, "Id %d is in a batch", txId)
 	}
 
@@ -128,7 +195,8 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		return sdkerrors.Wrapf(types.ErrInvalid, "Inconsistent tokens to cancel!: %s %s", tx.Erc20Fee.Contract, tx.Erc20Token.Contract)
 	}
 
-	// delete this tx from both indexes
+	// give the amount back to the outbound rate limit window, then delete this tx from both indexes
+	k.releaseOutboundRateLimit(ctx, tx)
 	k.removePoolEntry(ctx, txId)
 	k.removeFromUnbatchedTXIndex(ctx, *tx.Erc20Fee, txId)
 
@@ -217,13 +285,38 @@ func (k Keeper) removeFromUnbatchedTXIndex(ctx sdk.Context, fee types.ERC20Token
 	return sdkerrors.Wrap(types.ErrUnknown, "tx id")
 }
 
+// isUnbatched returns true if the tx is in the fee index, transfers in batches are left in the
+// pool but removed from the fee index
+func (k Keeper) isUnbatched(ctx sdk.Context, fee types.ERC20Token, txID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeSecondIndexKey(fee))
+	if bz == nil {
+		return false
+	}
+	var idSet types.IDSet
+	k.cdc.MustUnmarshalBinaryBare(bz, &idSet)
+	for _, id := range idSet.Ids {
+		if id == txID {
+			return true
+		}
+	}
+	return false
+}
+
+// setPoolEntry stores the tx and indexes it by sender and receiver
 func (k Keeper) setPoolEntry(ctx sdk.Context, val *types.OutgoingTransferTx) error {
 	bz, err := k.cdc.MarshalBinaryBare(val)
 	if err != nil {
 		return err
 	}
+	sender, err := sdk.AccAddressFromBech32(val.Sender)
+	if err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetOutgoingTxPoolKey(val.Id), bz)
+	store.Set(types.GetOutgoingTxBySenderKey(sender, val.Id), []byte{})
+	store.Set(types.GetOutgoingTxByReceiverKey(val.DestAddress, val.Id), []byte{})
 	return nil
 }
 
@@ -238,9 +331,20 @@ func (k Keeper) getPoolEntry(ctx sdk.Context, id uint64) (*types.OutgoingTransfe
 	return &r, nil
 }
 
+// removePoolEntry deletes the tx, its sender and receiver index entries and its outbound
+// rate limit usage
 func (k Keeper) removePoolEntry(ctx sdk.Context, id uint64) {
+	tx, err := k.getPoolEntry(ctx, id)
+	if err != nil {
+		return
+	}
+	k.deleteOutboundRateLimitTxUsage(ctx, id)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOutgoingTxPoolKey(id))
+	if sender, err := sdk.AccAddressFromBech32(tx.Sender); err == nil {
+		store.Delete(types.GetOutgoingTxBySenderKey(sender, id))
+	}
+	store.Delete(types.GetOutgoingTxByReceiverKey(tx.DestAddress, id))
 }
 
 // GetPoolTransactions, grabs all transactions from the tx pool, useful for queries or genesis save/load
@@ -286,19 +390,62 @@ func (k Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, contract string, cb fu
 	}
 }
 
+// IterateOutgoingPoolBySender iterates over the transfers of a sender by id, including the ones
+// in batches, inBatch is false for the transfers that are still in the fee index
+func (k Keeper) IterateOutgoingPoolBySender(ctx sdk.Context, sender sdk.AccAddress, cb func(tx *types.OutgoingTransferTx, inBatch bool) bool) {
+	k.iterateOutgoingPoolByIndex(ctx, types.GetOutgoingTxBySenderPrefix(sender), cb)
+}
+
+// IterateOutgoingPoolByReceiver iterates over the transfers to an Ethereum address by id,
+// including the ones in batches, inBatch is false for the transfers that are still in the fee index
+func (k Keeper) IterateOutgoingPoolByReceiver(ctx sdk.Context, receiver string, cb func(tx *types.OutgoingTransferTx, inBatch bool) bool) {
+	k.iterateOutgoingPoolByIndex(ctx, types.GetOutgoingTxByReceiverPrefix(receiver), cb)
+}
+
+func (k Keeper) iterateOutgoingPoolByIndex(ctx sdk.Context, indexPrefix []byte, cb func(tx *types.OutgoingTransferTx, inBatch bool) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		tx, inBatch := k.getIndexedPoolEntry(ctx, iter.Key())
+		// cb returns true to stop early
+		if cb(tx, inBatch) {
+			return
+		}
+	}
+}
+
+// getIndexedPoolEntry returns the tx a sender or receiver index entry points to, the key
+// being the tx id after the index prefix
+func (k Keeper) getIndexedPoolEntry(ctx sdk.Context, key []byte) (*types.OutgoingTransferTx, bool) {
+	tx, err := k.getPoolEntry(ctx, types.UInt64FromBytes(key))
+	if err != nil {
+		panic("Invalid id in tx index!")
+	}
+	return tx, !k.isUnbatched(ctx, *tx.Erc20Fee, tx.Id)
+}
+
 // GetBatchFeesByTokenType gets the fees the next batch of a given token type would
-// have if created. This info is both presented to relayers for the purpose of determining
-// when to request batches and also used by the batch creation process to decide not to create
-// a new batch
-func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr string) *types.BatchFees {
-	batchFeesMap := k.createBatchFees(ctx)
+// have if created with at most maxElements transactions. This info is both presented to
+// relayers for the purpose of determining when to request batches and also used by the
+// batch creation process to decide not to create a new batch
+func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr string, maxElements int) *types.BatchFees {
+	batchFeesMap := k.createBatchFees(ctx, tokenContractAddr, func(string) int { return maxElements }, nil)
 	return batchFeesMap[tokenContractAddr]
 }
 
 // GetAllBatchFees creates a fee entry for every batch type currently in the store
 // this can be used by relayers to determine what batch types are desireable to request
-func (k Keeper) GetAllBatchFees(ctx sdk.Context) (batchFees []*types.BatchFees) {
-	batchFeesMap := k.createBatchFees(ctx)
+func (k Keeper) GetAllBatchFees(ctx sdk.Context) []*types.BatchFees {
+	return k.getAllBatchFees(ctx, nil)
+}
+
+// getAllBatchFees is GetAllBatchFees, also filling oldestIDs with the lowest id of every token in
+// the pool if it is not nil, so that both come out of a single pass over the pool
+func (k Keeper) getAllBatchFees(ctx sdk.Context, oldestIDs map[string]uint64) (batchFees []*types.BatchFees) {
+	batchFeesMap := k.createBatchFees(ctx, "", func(tokenContract string) int {
+		return k.GetBatchSize(ctx, tokenContract)
+	}, oldestIDs)
 	// create array of batchFees
 	for _, batchFee := range batchFeesMap {
 		// newBatchFee := types.BatchFees{
@@ -317,14 +464,17 @@ func (k Keeper) GetAllBatchFees(ctx sdk.Context) (batchFees []*types.BatchFees)
 	return batchFees
 }
 
-// CreateBatchFees iterates over the outgoing pool and creates batch token fee map
-func (k Keeper) createBatchFees(ctx sdk.Context) map[string]*types.BatchFees {
+// CreateBatchFees iterates over the outgoing pool and creates batch token fee map, it
+// picks up to batchSize transactions of a token by fee desc like the batch creation does.
+// An empty tokenContract iterates over the pool of every token
+func (k Keeper) createBatchFees(ctx sdk.Context, tokenContract string, batchSize func(tokenContract string) int, oldestIDs map[string]uint64) map[string]*types.BatchFees {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
-	iter := prefixStore.Iterator(nil, nil)
+	iter := prefixStore.ReverseIterator(prefixRange([]byte(tokenContract)))
 	defer iter.Close()
 
 	batchFeesMap := make(map[string]*types.BatchFees)
 	txCountMap := make(map[string]int)
+	batchSizeMap := make(map[string]int)
 
 	for ; iter.Valid(); iter.Next() {
 		var ids types.IDSet
@@ -341,8 +491,21 @@ func (k Keeper) createBatchFees(ctx sdk.Context) map[string]*types.BatchFees {
 		feeAmountBytes := key[len(tokenContractBytes):]
 		feeAmount := big.NewInt(0).SetBytes(feeAmountBytes)
 
+		if _, ok := batchSizeMap[tokenContractAddr]; !ok {
+			batchSizeMap[tokenContractAddr] = batchSize(tokenContractAddr)
+		}
+
+		// ids are assigned in increasing order, the lowest one is the transfer that has been in the pool the longest
+		if oldestIDs != nil {
+			for _, id := range ids.Ids {
+				if oldest, ok := oldestIDs[tokenContractAddr]; !ok || id < oldest {
+					oldestIDs[tokenContractAddr] = id
+				}
+			}
+		}
+
 		for i := 0; i < len(ids.Ids); i++ {
-			if txCountMap[tokenContractAddr] >= OutgoingTxBatchSize {
+			if txCountMap[tokenContractAddr] >= batchSizeMap[tokenContractAddr] {
 				break
 			} else {
 				// add fee amount
@@ -359,6 +522,11 @@ func (k Keeper) createBatchFees(ctx sdk.Context) map[string]*types.BatchFees {
 		}
 	}
 
+	for token, batchFees := range batchFeesMap {
+		batchFees.TxCount = uint64(txCountMap[token])
+		batchFees.MeetsThreshold = k.checkBatchThreshold(ctx, *batchFees) == nil
+	}
+
 	return batchFeesMap
 }
 
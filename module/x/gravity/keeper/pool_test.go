@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -50,6 +52,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(101, myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          1,
@@ -57,6 +60,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(100, myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          3,
@@ -64,6 +68,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(102, myTokenContractAddr),
+			Block:       1234567,
 		},
 		{
 			Id:          4,
@@ -71,6 +76,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
 			Erc20Token:  types.NewERC20Token(103, myTokenContractAddr),
+			Block:       1234567,
 		},
 	}
 	assert.Equal(t, exp, got)
@@ -137,5 +143,185 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 		**/
 	assert.Equal(t, batchFees[0].TotalFees.BigInt(), big.NewInt(int64(8)))
 	assert.Equal(t, batchFees[1].TotalFees.BigInt(), big.NewInt(int64(500)))
+	assert.Equal(t, uint64(4), batchFees[0].TxCount)
+	assert.Equal(t, uint64(OutgoingTxBatchSize), batchFees[1].TxCount)
 
 }
+
+func TestOutgoingPoolIndexes(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender, _      = sdk.AccAddressFromBech32("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		otherReceiver       = "0xA9dD8D2ee6A3fD9E6E3bFf2B4C8C3C3f1fD2C0B1"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers.Add(allVouchers...)))
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		require.NoError(t, input.BankKeeper.SetBalances(ctx, sender, allVouchers))
+	}
+
+	// ids 1 to 4, the highest fee of id 2 goes into a batch
+	for i, tx := range []struct {
+		sender   sdk.AccAddress
+		receiver string
+		fee      uint64
+	}{
+		{mySender, myReceiver, 2},
+		{mySender, otherReceiver, 3},
+		{otherSender, myReceiver, 1},
+		{mySender, myReceiver, 1},
+	} {
+		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
+		fee := types.NewERC20Token(tx.fee, myTokenContractAddr).GravityCoin()
+		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, tx.sender, tx.receiver, amount, fee)
+		require.NoError(t, err)
+	}
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	require.Equal(t, uint64(2), batch.Transactions[0].Id)
+
+	pendingIDs := func(transfers []types.PendingTransfer) (ids []uint64, inBatch []bool) {
+		for _, transfer := range transfers {
+			ids = append(ids, transfer.Transfer.Id)
+			inBatch = append(inBatch, transfer.InBatch)
+		}
+		return ids, inBatch
+	}
+	bySender := func(sender sdk.AccAddress, pageReq *query.PageRequest) *types.QueryPendingTransfersBySenderResponse {
+		res, err := input.GravityKeeper.PendingTransfersBySender(sdk.WrapSDKContext(ctx),
+			&types.QueryPendingTransfersBySenderRequest{SenderAddress: sender.String(), Pagination: pageReq})
+		require.NoError(t, err)
+		return res
+	}
+	byReceiver := func(receiver string) []types.PendingTransfer {
+		res, err := input.GravityKeeper.PendingTransfersByReceiver(sdk.WrapSDKContext(ctx),
+			&types.QueryPendingTransfersByReceiverRequest{DestAddress: receiver})
+		require.NoError(t, err)
+		return res.Transfers
+	}
+
+	// the transfers of a sender are paginated by id and include the ones in batches
+	res := bySender(mySender, &query.PageRequest{Limit: 2})
+	ids, inBatch := pendingIDs(res.Transfers)
+	assert.Equal(t, []uint64{1, 2}, ids)
+	assert.Equal(t, []bool{false, true}, inBatch)
+	require.NotNil(t, res.Pagination.NextKey)
+	res = bySender(mySender, &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+	ids, _ = pendingIDs(res.Transfers)
+	assert.Equal(t, []uint64{4}, ids)
+
+	// the destination matches regardless of the checksum casing
+	ids, _ = pendingIDs(byReceiver(strings.ToLower(myReceiver)))
+	assert.Equal(t, []uint64{1, 3, 4}, ids)
+
+	// a transfer in a batch can't be canceled, an unbatched one leaves both indexes
+	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 2, mySender)
+	require.Error(t, err)
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 4, mySender))
+	ids, _ = pendingIDs(bySender(mySender, nil).Transfers)
+	assert.Equal(t, []uint64{1, 2}, ids)
+	ids, _ = pendingIDs(byReceiver(myReceiver))
+	assert.Equal(t, []uint64{1, 3}, ids)
+
+	// the indexes are restored from genesis, including the transfers in batches
+	fresh := CreateTestEnv(t)
+	InitGenesis(fresh.Context, fresh.GravityKeeper, ExportGenesis(ctx, input.GravityKeeper))
+	assert.Equal(t, input.GravityKeeper.GetPoolTransactions(ctx), fresh.GravityKeeper.GetPoolTransactions(fresh.Context))
+	freshRes, err := fresh.GravityKeeper.PendingTransfersBySender(sdk.WrapSDKContext(fresh.Context),
+		&types.QueryPendingTransfersBySenderRequest{SenderAddress: mySender.String()})
+	require.NoError(t, err)
+	assert.Equal(t, bySender(mySender, nil).Transfers, freshRes.Transfers)
+
+	// executing the batch removes its transfers from the indexes
+	require.NoError(t, input.GravityKeeper.OutgoingTxBatchExecuted(ctx, myTokenContractAddr, batch.BatchNonce))
+	ids, inBatch = pendingIDs(bySender(mySender, nil).Transfers)
+	assert.Equal(t, []uint64{1}, ids)
+	assert.Equal(t, []bool{false}, inBatch)
+	assert.Empty(t, byReceiver(otherReceiver))
+}
+
+func TestIncreaseBridgeFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		notMySender, _      = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3km")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenContract  = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			types.NewERC20Token(99999, otherTokenContract).GravityCoin(),
+		)
+		myDenom = types.NewERC20Token(1, myTokenContractAddr).GravityCoin().Denom
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i, v := range []uint64{2, 3, 2, 1} {
+		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
+		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+		require.NoError(t, err)
+	}
+	// id 2 with the highest fee goes into a batch
+	_, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+	require.NoError(t, err)
+
+	poolOrder := func() (ids []uint64) {
+		k.IterateOutgoingPoolByFee(ctx, myTokenContractAddr, func(id uint64, _ *types.OutgoingTransferTx) bool {
+			ids = append(ids, id)
+			return false
+		})
+		return ids
+	}
+	require.Equal(t, []uint64{1, 3, 4}, poolOrder())
+	balance := input.BankKeeper.GetBalance(ctx, mySender, myDenom).Amount
+	supply := input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(myDenom)
+
+	// the fee of id 4 goes from 1 to 3, the fee is burned like for MsgSendToEth
+	require.NoError(t, k.IncreaseBridgeFee(ctx, 4, mySender, types.NewERC20Token(2, myTokenContractAddr).GravityCoin()))
+	assert.Equal(t, []uint64{4, 1, 3}, poolOrder())
+	assert.Equal(t, balance.SubRaw(2), input.BankKeeper.GetBalance(ctx, mySender, myDenom).Amount)
+	assert.Equal(t, supply.SubRaw(2), input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(myDenom))
+	tx, err := k.getPoolEntry(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(3), tx.Erc20Fee.Amount)
+
+	// a tx that reaches the fee of another one goes behind it
+	require.NoError(t, k.IncreaseBridgeFee(ctx, 1, mySender, types.NewERC20Token(1, myTokenContractAddr).GravityCoin()))
+	assert.Equal(t, []uint64{4, 1, 3}, poolOrder())
+	assert.Equal(t, sdk.NewInt(8), k.GetBatchFeesByTokenType(ctx, myTokenContractAddr, 100).TotalFees)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		found = found || event.Type == types.EventTypeBridgeFeeIncreased
+	}
+	assert.True(t, found)
+
+	specs := map[string]struct {
+		id     uint64
+		sender sdk.AccAddress
+		fee    sdk.Coin
+	}{
+		"in a batch":     {id: 2, sender: mySender, fee: types.NewERC20Token(1, myTokenContractAddr).GravityCoin()},
+		"not the sender": {id: 3, sender: notMySender, fee: types.NewERC20Token(1, myTokenContractAddr).GravityCoin()},
+		"other token":    {id: 3, sender: mySender, fee: types.NewERC20Token(1, otherTokenContract).GravityCoin()},
+		"unknown id":     {id: 5, sender: mySender, fee: types.NewERC20Token(1, myTokenContractAddr).GravityCoin()},
+		"above balance":  {id: 3, sender: mySender, fee: types.NewERC20Token(999999, myTokenContractAddr).GravityCoin()},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			assert.Error(t, k.IncreaseBridgeFee(xCtx, spec.id, spec.sender, spec.fee))
+		})
+	}
+}
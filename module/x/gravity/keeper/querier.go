@@ -248,10 +248,11 @@ func lastPendingValsetRequest(ctx sdk.Context, operatorAddr string, keeper Keepe
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
+	validator := keeper.GetOrchestratorValidator(ctx, addr)
 	var pendingValsetReq []*types.Valset
 	keeper.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
-		// foundConfirm is true if the operatorAddr has signed the valset we are currently looking at
-		foundConfirm := keeper.GetValsetConfirm(ctx, val.Nonce, addr) != nil
+		// foundConfirm is true if the validator of the operatorAddr has signed the valset we are currently looking at
+		foundConfirm := keeper.GetValsetConfirm(ctx, val.Nonce, validator) != nil
 		// if this valset has NOT been signed by operatorAddr, store it in pendingValsetReq
 		// and exit the loop
 		if !foundConfirm {
@@ -298,7 +299,8 @@ func queryValsetConfirm(ctx sdk.Context, path []string, keeper Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	valset := keeper.GetValsetConfirm(ctx, nonce, accAddress)
+	// confirms are stored by validator, any of its orchestrator keys finds the confirm
+	valset := keeper.GetValsetConfirm(ctx, nonce, keeper.GetOrchestratorValidator(ctx, accAddress))
 	if valset == nil {
 		return nil, nil
 	}
@@ -322,9 +324,10 @@ func lastPendingBatchRequest(ctx sdk.Context, operatorAddr string, keeper Keeper
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
+	validator := keeper.GetOrchestratorValidator(ctx, addr)
 	var pendingBatchReq *types.OutgoingTxBatch
 	keeper.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
-		foundConfirm := keeper.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, addr) != nil
+		foundConfirm := keeper.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, validator) != nil
 		if !foundConfirm {
 			pendingBatchReq = batch
 			return true
@@ -413,9 +416,10 @@ func lastPendingLogicCallRequest(ctx sdk.Context, operatorAddr string, keeper Ke
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
+	validator := keeper.GetOrchestratorValidator(ctx, addr)
 	var pendingLogicCalls *types.OutgoingLogicCall
 	keeper.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
-		foundConfirm := keeper.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, addr) != nil
+		foundConfirm := keeper.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, validator) != nil
 		if !foundConfirm {
 			pendingLogicCalls = call
 			return true
@@ -518,22 +522,11 @@ func queryERC20ToDenom(ctx sdk.Context, ERC20 string, keeper Keeper) ([]byte, er
 }
 
 func queryPendingSendToEth(ctx sdk.Context, senderAddr string, k Keeper) ([]byte, error) {
-	batches := k.GetOutgoingTxBatches(ctx)
-	unbatched_tx := k.GetPoolTransactions(ctx)
-	sender_address := senderAddr
-	res := types.QueryPendingSendToEthResponse{}
-	for _, batch := range batches {
-		for _, tx := range batch.Transactions {
-			if tx.Sender == sender_address {
-				res.TransfersInBatches = append(res.TransfersInBatches, tx)
-			}
-		}
-	}
-	for _, tx := range unbatched_tx {
-		if tx.Sender == sender_address {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx)
-		}
+	sender, err := sdk.AccAddressFromBech32(senderAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
+	res := k.getPendingSendToEth(ctx, sender)
 	bytes, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -24,7 +24,8 @@ func TestQueryValsetConfirm(t *testing.T) {
 	)
 	input := CreateTestEnv(t)
 	ctx := input.Context
-	input.GravityKeeper.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+	input.GravityKeeper.SetOrchestratorValidator(ctx, sdk.ValAddress(myValidatorCosmosAddr), myValidatorCosmosAddr)
+	input.GravityKeeper.SetValsetConfirm(ctx, sdk.ValAddress(myValidatorCosmosAddr), types.MsgValsetConfirm{
 		Nonce:        nonce,
 		Orchestrator: myValidatorCosmosAddr.String(),
 		EthAddress:   myValidatorEthereumAddr.String(),
@@ -91,7 +92,7 @@ func TestAllValsetConfirmsBynonce(t *testing.T) {
 		msg.Nonce = uint64(1)
 		msg.Orchestrator = addr.String()
 		msg.Signature = fmt.Sprintf("signature %d", i+1)
-		input.GravityKeeper.SetValsetConfirm(ctx, msg)
+		input.GravityKeeper.SetValsetConfirm(ctx, sdk.ValAddress(addr), msg)
 	}
 
 	specs := map[string]struct {
@@ -157,7 +158,7 @@ func TestLastValsetRequests(t *testing.T) {
 		"limit at 5": {
 			expResp: []byte(`[
 {
-  "nonce": "105",
+  "nonce": "6",
   "height": "105",
   "members": [
     {
@@ -187,7 +188,7 @@ func TestLastValsetRequests(t *testing.T) {
   ]
 },
 {
-  "nonce": "104",
+  "nonce": "5",
   "height": "104",
   "members": [
     {
@@ -213,7 +214,7 @@ func TestLastValsetRequests(t *testing.T) {
   ]
 },
 {
-  "nonce": "103",
+  "nonce": "4",
   "height": "103",
   "members": [
     {
@@ -235,7 +236,7 @@ func TestLastValsetRequests(t *testing.T) {
   ]
 },
 {
-  "nonce": "102",
+  "nonce": "3",
   "height": "102",
   "members": [
     {
@@ -253,7 +254,7 @@ func TestLastValsetRequests(t *testing.T) {
   ]
 },
 {
-  "nonce": "101",
+  "nonce": "2",
   "height": "101",
   "members": [
     {
@@ -304,7 +305,7 @@ func TestPendingValsetRequests(t *testing.T) {
 		"find valset": {
 			expResp: []byte(`[
                                   {
-                                    "nonce": "105",
+                                    "nonce": "6",
                                     "members": [
                                       {
                                         "power": "715827882",
@@ -334,7 +335,7 @@ func TestPendingValsetRequests(t *testing.T) {
                                     "height": "105"
                                   },
                                   {
-                                    "nonce": "104",
+                                    "nonce": "5",
                                     "members": [
                                       {
                                         "power": "858993459",
@@ -360,7 +361,7 @@ func TestPendingValsetRequests(t *testing.T) {
                                     "height": "104"
                                   },
                                   {
-                                    "nonce": "103",
+                                    "nonce": "4",
                                     "members": [
                                       {
                                         "power": "1073741823",
@@ -382,7 +383,7 @@ func TestPendingValsetRequests(t *testing.T) {
                                     "height": "103"
                                   },
                                   {
-                                    "nonce": "102",
+                                    "nonce": "3",
                                     "members": [
                                       {
                                         "power": "1431655765",
@@ -400,7 +401,7 @@ func TestPendingValsetRequests(t *testing.T) {
                                     "height": "102"
                                   },
                                   {
-                                    "nonce": "101",
+                                    "nonce": "2",
                                     "members": [
                                       {
                                         "power": "2147483647",
@@ -414,7 +415,7 @@ func TestPendingValsetRequests(t *testing.T) {
                                     "height": "101"
                                   },
                                   {
-                                    "nonce": "100",
+                                    "nonce": "1",
                                     "members": [
                                       {
                                         "power": "4294967295",
@@ -479,7 +480,8 @@ func TestLastPendingBatchRequest(t *testing.T) {
 		"erc20_fee": {
 			"amount": "3",
 			"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
-		}
+		},
+		"block": "1234567"
 		},
 		{
 		"id": "1",
@@ -492,7 +494,8 @@ func TestLastPendingBatchRequest(t *testing.T) {
 		"erc20_fee": {
 			"amount": "2",
 			"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
-		}
+		},
+		"block": "1234567"
 		}
 	],
 	"token_contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
@@ -552,7 +555,7 @@ func TestQueryAllBatchConfirms(t *testing.T) {
 		validatorAddr, _ = sdk.AccAddressFromBech32("cosmos1mgamdcs9dah0vn0gqupl05up7pedg2mvupe6hh")
 	)
 
-	input.GravityKeeper.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+	input.GravityKeeper.SetBatchConfirm(ctx, sdk.ValAddress(validatorAddr), &types.MsgConfirmBatch{
 		Nonce:         1,
 		TokenContract: tokenContract,
 		EthSigner:     "0xf35e2cc8e6523d683ed44870f5b7cc785051a77d",
@@ -608,7 +611,7 @@ func TestQueryLogicCalls(t *testing.T) {
 		InvalidationId:       invalidationId,
 		InvalidationNonce:    uint64(invalidationNonce),
 	}
-	k.SetOutgoingLogicCall(ctx, &call)
+	k.setOutgoingLogicCall(ctx, &call)
 
 	res := k.GetOutgoingLogicCall(ctx, invalidationId, invalidationNonce)
 
@@ -664,7 +667,7 @@ func TestQueryLogicCallsConfirms(t *testing.T) {
 		InvalidationId:       invalidationId,
 		InvalidationNonce:    uint64(invalidationNonce),
 	}
-	k.SetOutgoingLogicCall(ctx, &call)
+	k.setOutgoingLogicCall(ctx, &call)
 
 	var valAddr sdk.AccAddress = bytes.Repeat([]byte{byte(1)}, sdk.AddrLen)
 
@@ -676,7 +679,7 @@ func TestQueryLogicCallsConfirms(t *testing.T) {
 		Signature:         "test",
 	}
 
-	k.SetLogicCallConfirm(ctx, &confirm)
+	k.SetLogicCallConfirm(ctx, sdk.ValAddress(valAddr), &confirm)
 
 	res := k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationId, 1)
 	assert.Equal(t, len(res), 1)
@@ -712,7 +715,8 @@ func TestQueryBatch(t *testing.T) {
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "2"
+			  "id": "2",
+			  "block": "1234567"
 			},
 			{
 			  "erc20_fee": {
@@ -725,7 +729,8 @@ func TestQueryBatch(t *testing.T) {
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "1"
+			  "id": "1",
+			  "block": "1234567"
 			}
 		  ],
 		  "batch_nonce": "1",
@@ -763,7 +768,8 @@ func TestLastBatchesRequest(t *testing.T) {
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "6"
+			  "id": "6",
+			  "block": "1234567"
 			},
 			{
 			  "erc20_fee": {
@@ -776,7 +782,8 @@ func TestLastBatchesRequest(t *testing.T) {
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "3"
+			  "id": "3",
+			  "block": "1234567"
 			}
 		  ],
 		  "batch_nonce": "2",
@@ -796,7 +803,8 @@ func TestLastBatchesRequest(t *testing.T) {
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "2"
+			  "id": "2",
+			  "block": "1234567"
 			},
 			{
 			  "erc20_fee": {
@@ -809,7 +817,8 @@ func TestLastBatchesRequest(t *testing.T) {
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "1"
+			  "id": "1",
+			  "block": "1234567"
 			}
 		  ],
 		  "batch_nonce": "1",
@@ -836,7 +845,7 @@ func TestQueryCurrentValset(t *testing.T) {
 	currentValset := input.GravityKeeper.GetCurrentValset(ctx)
 
 	bridgeVal := types.BridgeValidator{EthereumAddress: ethAddress, Power: 4294967295}
-	expectedValset := types.Valset{Nonce: 1234567, Height: 1234567, Members: []*types.BridgeValidator{&bridgeVal}}
+	expectedValset := types.Valset{Nonce: 1, Height: 1234567, Members: []*types.BridgeValidator{&bridgeVal}}
 	assert.Equal(t, &expectedValset, currentValset)
 }
 
@@ -925,30 +934,32 @@ func TestQueryPendingSendToEth(t *testing.T) {
 	expectedJSON := []byte(`{
   "transfers_in_batches": [
     {
-      "id": "2",
+      "id": "1",
       "sender": "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
       "dest_address": "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
       "erc20_token": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
-        "amount": "101"
+        "amount": "100"
       },
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
-        "amount": "3"
-      }
+        "amount": "2"
+      },
+      "block": "1234567"
     },
     {
-      "id": "1",
+      "id": "2",
       "sender": "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
       "dest_address": "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
       "erc20_token": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
-        "amount": "100"
+        "amount": "101"
       },
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
-        "amount": "2"
-      }
+        "amount": "3"
+      },
+      "block": "1234567"
     }
   ],
   "unbatched_transfers": [
@@ -963,7 +974,8 @@ func TestQueryPendingSendToEth(t *testing.T) {
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
         "amount": "2"
-      }
+      },
+      "block": "1234567"
     },
     {
       "id": "4",
@@ -976,7 +988,8 @@ func TestQueryPendingSendToEth(t *testing.T) {
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
         "amount": "1"
-      }
+      },
+      "block": "1234567"
     }
   ]}
 	  `)
@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   OUTBOUND RATE LIMITS  //
+/////////////////////////////
+
+// GetOutboundRateLimitWindow returns the number of blocks outbound rate limits apply to
+func (k Keeper) GetOutboundRateLimitWindow(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyOutboundRateLimitWindow, &a)
+	return a
+}
+
+// GetOutboundRateLimit returns the outbound rate limit of a token, falling back to the
+// limit without a token contract if the token has no limit of its own
+func (k Keeper) GetOutboundRateLimit(ctx sdk.Context, tokenContract string) (types.OutboundRateLimit, bool) {
+	var limits []types.OutboundRateLimit
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyOutboundRateLimits, &limits)
+
+	var (
+		fallback types.OutboundRateLimit
+		found    bool
+	)
+	for _, limit := range limits {
+		switch {
+		case strings.EqualFold(limit.TokenContract, tokenContract):
+			return limit, true
+		case limit.TokenContract == "":
+			fallback, found = limit, true
+		}
+	}
+	return fallback, found
+}
+
+// GetOutboundGlobalWindowLimit returns the limit on the amount of all tokens together sent to
+// Ethereum within the outbound rate limit window, zero if it is disabled
+func (k Keeper) GetOutboundGlobalWindowLimit(ctx sdk.Context) sdk.Int {
+	a := sdk.ZeroInt()
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyOutboundGlobalWindowLimit, &a)
+	return a
+}
+
+// GetOutboundRateLimitUsage returns the amount of a token sent to Ethereum within the
+// current outbound rate limit window, including fees
+func (k Keeper) GetOutboundRateLimitUsage(ctx sdk.Context, tokenContract string) sdk.Int {
+	return k.getRateLimitUsage(ctx, types.OutboundRateLimitUsageKey, tokenContract, k.GetOutboundRateLimitWindow(ctx))
+}
+
+// GetOutboundGlobalRateLimitUsage returns the amount of all tokens together sent to Ethereum
+// within the current outbound rate limit window, including fees
+func (k Keeper) GetOutboundGlobalRateLimitUsage(ctx sdk.Context) sdk.Int {
+	return k.getRateLimitUsage(ctx, types.OutboundGlobalRateLimitUsageKey, "", k.GetOutboundRateLimitWindow(ctx))
+}
+
+// checkOutboundRateLimit checks an outgoing amount against the outbound rate limit of its token
+// and against the global limit on all tokens together
+func (k Keeper) checkOutboundRateLimit(ctx sdk.Context, tokenContract string, amount sdk.Int) error {
+	if limit, found := k.GetOutboundRateLimit(ctx, tokenContract); found {
+		if !limit.MaxTransfer.IsZero() && amount.GT(limit.MaxTransfer) {
+			return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "transfer of %s above max transfer %s", amount, limit.MaxTransfer)
+		}
+		if usage := k.GetOutboundRateLimitUsage(ctx, tokenContract); !limit.WindowLimit.IsZero() && usage.Add(amount).GT(limit.WindowLimit) {
+			return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "window usage %s plus %s above window limit %s", usage, amount, limit.WindowLimit)
+		}
+	}
+	if limit := k.GetOutboundGlobalWindowLimit(ctx); !limit.IsZero() {
+		if usage := k.GetOutboundGlobalRateLimitUsage(ctx); usage.Add(amount).GT(limit) {
+			return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "global window usage %s plus %s above global window limit %s", usage, amount, limit)
+		}
+	}
+	return nil
+}
+
+// useOutboundRateLimit adds an outgoing amount of a pool transfer to the usage of its token and
+// the global usage of the current window, it is recorded for the transfer as well so that it can
+// be released on cancel
+func (k Keeper) useOutboundRateLimit(ctx sdk.Context, txID uint64, tokenContract string, amount sdk.Int) {
+	window := k.GetOutboundRateLimitWindow(ctx)
+	if window == 0 {
+		return
+	}
+	k.addRateLimitUsage(ctx, types.OutboundRateLimitUsageKey, tokenContract, window, amount)
+	k.addRateLimitUsage(ctx, types.OutboundGlobalRateLimitUsageKey, "", window, amount)
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOutboundRateLimitTxUsageKey(txID, uint64(ctx.BlockHeight()))
+	used := sdk.ZeroInt()
+	if bz := store.Get(key); bz != nil {
+		if err := used.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	bz, err := used.Add(amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// releaseOutboundRateLimit gives the usage of a canceled pool transfer back to its window,
+// otherwise sending and canceling would fill the window of a token for everyone else. Usage
+// that already fell out of the window has nothing left to give back
+func (k Keeper) releaseOutboundRateLimit(ctx sdk.Context, tx *types.OutgoingTransferTx) {
+	tokenContract := normalizeTokenContract(tx.Erc20Token.Contract)
+	store := ctx.KVStore(k.storeKey)
+	release := func(key []byte, amount sdk.Int) {
+		bz := store.Get(key)
+		if bz == nil {
+			return
+		}
+		used := sdk.ZeroInt()
+		if err := used.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		if used = used.Sub(amount); !used.IsPositive() {
+			store.Delete(key)
+			return
+		}
+		bz, err := used.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+	}
+
+	prefixStore := prefix.NewStore(store, types.GetOutboundRateLimitTxUsagePrefix(tx.Id))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		amount := sdk.ZeroInt()
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		height := types.UInt64FromBytes(iter.Key())
+		release(types.GetRateLimitUsageKey(types.OutboundRateLimitUsageKey, tokenContract, height), amount)
+		release(types.GetRateLimitUsageKey(types.OutboundGlobalRateLimitUsageKey, "", height), amount)
+	}
+}
+
+// deleteOutboundRateLimitTxUsage removes the usage recorded for a pool transfer
+func (k Keeper) deleteOutboundRateLimitTxUsage(ctx sdk.Context, txID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.GetOutboundRateLimitTxUsagePrefix(txID))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+/////////////////////////////
+//   INBOUND RATE LIMITS   //
+/////////////////////////////
+
+// GetInboundRateLimitWindow returns the number of blocks inbound rate limits apply to
+func (k Keeper) GetInboundRateLimitWindow(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyInboundRateLimitWindow, &a)
+	return a
+}
+
+// GetInboundRateLimit returns the inbound rate limit of a token, falling back to the
+// limit without a token contract if the token has no limit of its own
+func (k Keeper) GetInboundRateLimit(ctx sdk.Context, tokenContract string) (types.InboundRateLimit, bool) {
+	var limits []types.InboundRateLimit
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyInboundRateLimits, &limits)
+
+	var (
+		global types.InboundRateLimit
+		found  bool
+	)
+	for _, limit := range limits {
+		switch {
+		case strings.EqualFold(limit.TokenContract, tokenContract):
+			return limit, true
+		case limit.TokenContract == "":
+			global, found = limit, true
+		}
+	}
+	return global, found
+}
+
+// GetInboundRateLimitUsage returns the amount of a token deposited from Ethereum that was
+// credited within the current inbound rate limit window
+func (k Keeper) GetInboundRateLimitUsage(ctx sdk.Context, tokenContract string) sdk.Int {
+	return k.getRateLimitUsage(ctx, types.InboundRateLimitUsageKey, tokenContract, k.GetInboundRateLimitWindow(ctx))
+}
+
+// inboundRateLimitAllows returns true if a deposit can be credited in full without exceeding
+// the inbound rate limit of its token
+func (k Keeper) inboundRateLimitAllows(ctx sdk.Context, deposit types.QueuedDeposit) bool {
+	limit, found := k.GetInboundRateLimit(ctx, deposit.Deposit.TokenContract)
+	if !found || limit.WindowLimit.IsZero() || k.GetInboundRateLimitWindow(ctx) == 0 {
+		return true
+	}
+	usage := k.GetInboundRateLimitUsage(ctx, deposit.Deposit.TokenContract)
+	return usage.Add(deposit.Deposit.Amount).LTE(limit.WindowLimit)
+}
+
+/////////////////////////////
+//    RATE LIMIT USAGE     //
+/////////////////////////////
+
+// getRateLimitUsage returns the amount of a token stored under the usage prefix within the
+// last window blocks
+func (k Keeper) getRateLimitUsage(ctx sdk.Context, usagePrefix []byte, tokenContract string, window uint64) sdk.Int {
+	usage := sdk.ZeroInt()
+	if window == 0 {
+		return usage
+	}
+	k.iterateRateLimitUsage(ctx, usagePrefix, tokenContract, rateLimitWindowStart(ctx, window), func(_ []byte, amount sdk.Int) bool {
+		usage = usage.Add(amount)
+		return false
+	})
+	return usage
+}
+
+// addRateLimitUsage adds an amount of a token to the usage of the current block, removing
+// the usage that fell out of the window
+func (k Keeper) addRateLimitUsage(ctx sdk.Context, usagePrefix []byte, tokenContract string, window uint64, amount sdk.Int) {
+	if window == 0 {
+		return
+	}
+	tokenContract = normalizeTokenContract(tokenContract)
+	store := ctx.KVStore(k.storeKey)
+
+	var expired [][]byte
+	start := rateLimitWindowStart(ctx, window)
+	k.iterateRateLimitUsage(ctx, usagePrefix, tokenContract, 0, func(key []byte, _ sdk.Int) bool {
+		if types.UInt64FromBytes(key) >= start {
+			return true
+		}
+		expired = append(expired, key)
+		return false
+	})
+	for _, key := range expired {
+		store.Delete(append(types.GetRateLimitUsagePrefix(usagePrefix, tokenContract), key...))
+	}
+
+	key := types.GetRateLimitUsageKey(usagePrefix, tokenContract, uint64(ctx.BlockHeight()))
+	used := sdk.ZeroInt()
+	if bz := store.Get(key); bz != nil {
+		if err := used.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	bz, err := used.Add(amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// iterateRateLimitUsage iterates through the amounts of a token stored under the usage prefix
+// per block height, starting at the given height in ASC order. The key passed to cb is the
+// block height
+func (k Keeper) iterateRateLimitUsage(ctx sdk.Context, usagePrefix []byte, tokenContract string, start uint64, cb func([]byte, sdk.Int) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRateLimitUsagePrefix(usagePrefix, normalizeTokenContract(tokenContract)))
+	iter := prefixStore.Iterator(types.UInt64Bytes(start), nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		amount := sdk.ZeroInt()
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		// cb returns true to stop early
+		if cb(iter.Key(), amount) {
+			break
+		}
+	}
+}
+
+// rateLimitWindowStart returns the first block height of the current window
+func rateLimitWindowStart(ctx sdk.Context, window uint64) uint64 {
+	height := uint64(ctx.BlockHeight())
+	if height < window {
+		return 0
+	}
+	return height - window + 1
+}
+
+// normalizeTokenContract returns the checksummed form of a token contract, so that state
+// kept per token is found regardless of how the address is cased. State kept for all tokens
+// together, like the global outbound usage, has no token contract
+func normalizeTokenContract(tokenContract string) string {
+	if tokenContract == "" {
+		return ""
+	}
+	return gethcommon.HexToAddress(tokenContract).Hex()
+}
@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestOutboundRateLimits(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender            = AccAddrs[0]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenContract  = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			types.NewERC20Token(99999, otherTokenContract).GravityCoin(),
+		)
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	send := func(ctx sdk.Context, contract string, amount uint64) error {
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver,
+			types.NewERC20Token(amount, contract).GravityCoin(), types.NewERC20Token(1, contract).GravityCoin())
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	params.OutboundRateLimitWindow = 10
+	params.OutboundRateLimits = []types.OutboundRateLimit{
+		{TokenContract: strings.ToLower(myTokenContractAddr), WindowLimit: sdk.NewInt(300), MaxTransfer: sdk.NewInt(150)},
+		{WindowLimit: sdk.NewInt(50), MaxTransfer: sdk.ZeroInt()},
+	}
+	k.SetParams(ctx, params)
+
+	// max single transfer, including the fee
+	require.True(t, types.ErrRateLimitExceeded.Is(send(ctx, myTokenContractAddr, 150)))
+	require.NoError(t, send(ctx, myTokenContractAddr, 149))
+
+	// window limit
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.NoError(t, send(ctx, myTokenContractAddr, 99))
+	require.True(t, types.ErrRateLimitExceeded.Is(send(ctx, myTokenContractAddr, 99)))
+	require.Equal(t, sdk.NewInt(250), k.GetOutboundRateLimitUsage(ctx, myTokenContractAddr))
+
+	res, err := k.OutboundRateLimitUsage(sdk.WrapSDKContext(ctx), &types.QueryOutboundRateLimitUsageRequest{TokenContract: myTokenContractAddr})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(250), res.WindowUsage)
+	require.Equal(t, uint64(10), res.Window)
+	require.Equal(t, sdk.NewInt(300), res.Limit.WindowLimit)
+
+	// the first transfer falls out of the window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.Equal(t, sdk.NewInt(100), k.GetOutboundRateLimitUsage(ctx, myTokenContractAddr))
+	require.NoError(t, send(ctx, myTokenContractAddr, 149))
+
+	// tokens without a limit of their own use the fallback one
+	require.NoError(t, send(ctx, otherTokenContract, 49))
+	require.True(t, types.ErrRateLimitExceeded.Is(send(ctx, otherTokenContract, 1)))
+}
+
+func TestOutboundRateLimitReleasedOnCancel(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender            = AccAddrs[0]
+		otherSender         = AccAddrs[1]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, allVouchers))
+	}
+	send := func(ctx sdk.Context, sender sdk.AccAddress, amount uint64) (uint64, error) {
+		return k.AddToOutgoingPool(ctx, sender, myReceiver,
+			types.NewERC20Token(amount, myTokenContractAddr).GravityCoin(), types.NewERC20Token(1, myTokenContractAddr).GravityCoin())
+	}
+
+	params := k.GetParams(ctx)
+	params.OutboundRateLimitWindow = 10
+	params.OutboundRateLimits = []types.OutboundRateLimit{
+		{TokenContract: myTokenContractAddr, WindowLimit: sdk.NewInt(300), MaxTransfer: sdk.ZeroInt()},
+	}
+	k.SetParams(ctx, params)
+
+	// when
+	id, err := send(ctx, mySender, 199)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, k.IncreaseBridgeFee(ctx, id, mySender, types.NewERC20Token(100, myTokenContractAddr).GravityCoin()))
+	_, err = send(ctx, otherSender, 1)
+	require.True(t, types.ErrRateLimitExceeded.Is(err))
+
+	// then the cancel gives the amount and the fees back to the window
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, id, mySender))
+	require.Equal(t, sdk.ZeroInt(), k.GetOutboundRateLimitUsage(ctx, myTokenContractAddr))
+	id, err = send(ctx, otherSender, 199)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(200), k.GetOutboundRateLimitUsage(ctx, myTokenContractAddr))
+
+	// usage that fell out of the window is not given back twice
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = send(ctx, mySender, 99)
+	require.NoError(t, err)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, id, otherSender))
+	require.Equal(t, sdk.NewInt(100), k.GetOutboundRateLimitUsage(ctx, myTokenContractAddr))
+}
+
+func TestOutboundGlobalRateLimit(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender            = AccAddrs[0]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenContract  = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			types.NewERC20Token(99999, otherTokenContract).GravityCoin(),
+		)
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	send := func(ctx sdk.Context, contract string, amount uint64) (uint64, error) {
+		return k.AddToOutgoingPool(ctx, mySender, myReceiver,
+			types.NewERC20Token(amount, contract).GravityCoin(), types.NewERC20Token(1, contract).GravityCoin())
+	}
+
+	params := k.GetParams(ctx)
+	params.OutboundRateLimitWindow = 10
+	params.OutboundRateLimits = []types.OutboundRateLimit{
+		{WindowLimit: sdk.NewInt(300), MaxTransfer: sdk.ZeroInt()},
+	}
+	params.OutboundGlobalWindowLimit = sdk.NewInt(400)
+	k.SetParams(ctx, params)
+
+	// every token stays below its own limit, together they reach the global one
+	_, err := send(ctx, myTokenContractAddr, 249)
+	require.NoError(t, err)
+	id, err := send(ctx, otherTokenContract, 149)
+	require.NoError(t, err)
+	_, err = send(ctx, otherTokenContract, 1)
+	require.True(t, types.ErrRateLimitExceeded.Is(err))
+	require.Equal(t, sdk.NewInt(150), k.GetOutboundRateLimitUsage(ctx, otherTokenContract))
+	require.Equal(t, sdk.NewInt(400), k.GetOutboundGlobalRateLimitUsage(ctx))
+
+	res, err := k.OutboundRateLimitUsage(sdk.WrapSDKContext(ctx), &types.QueryOutboundRateLimitUsageRequest{TokenContract: otherTokenContract})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(150), res.WindowUsage)
+	require.Equal(t, sdk.NewInt(400), res.GlobalWindowUsage)
+	require.Equal(t, sdk.NewInt(400), res.GlobalWindowLimit)
+
+	// a cancel gives the amount back to the global window as well
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, id, mySender))
+	require.Equal(t, sdk.NewInt(250), k.GetOutboundGlobalRateLimitUsage(ctx))
+	_, err = send(ctx, otherTokenContract, 149)
+	require.NoError(t, err)
+
+	// the token limit still applies on its own
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = send(ctx, myTokenContractAddr, 300)
+	require.True(t, types.ErrRateLimitExceeded.Is(err))
+}
+
+func TestInboundRateLimits(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		myReceiver          = AccAddrs[0]
+		otherReceiver       = AccAddrs[1]
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		denom               = types.NewERC20Token(1, myTokenContractAddr).GravityCoin().Denom
+		nonce               uint64
+	)
+	deposit := func(ctx sdk.Context, receiver sdk.AccAddress, amount int64) {
+		nonce++
+		claim := &types.MsgDepositClaim{
+			EventNonce:     nonce,
+			TokenContract:  myTokenContractAddr,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: receiver.String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+	balance := func(receiver sdk.AccAddress) int64 {
+		return input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64()
+	}
+
+	params := k.GetParams(ctx)
+	params.InboundRateLimitWindow = 10
+	params.InboundRateLimits = []types.InboundRateLimit{{WindowLimit: sdk.NewInt(100)}}
+	k.SetParams(ctx, params)
+
+	// deposits within the limit are credited at once, the rest is queued in order
+	deposit(ctx, myReceiver, 60)
+	deposit(ctx, myReceiver, 60)
+	deposit(ctx, otherReceiver, 30)
+	require.Equal(t, int64(60), balance(myReceiver))
+	require.Len(t, k.GetQueuedDeposits(ctx), 2)
+
+	res, err := k.QueuedDepositsByReceiver(sdk.WrapSDKContext(ctx), &types.QueryQueuedDepositsByReceiverRequest{Receiver: otherReceiver.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Deposits, 1)
+	require.Equal(t, uint64(3), res.Deposits[0].Deposit.EventNonce)
+	require.Equal(t, uint64(ctx.BlockHeight()), res.Deposits[0].QueuedHeight)
+
+	// the later deposit fits but waits for the earlier one
+	k.ReleaseQueuedDeposits(ctx)
+	require.Len(t, k.GetQueuedDeposits(ctx), 2)
+
+	// once the first deposit falls out of the window both are released
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.ReleaseQueuedDeposits(ctx)
+	require.Empty(t, k.GetQueuedDeposits(ctx))
+	require.Equal(t, int64(120), balance(myReceiver))
+	require.Equal(t, int64(30), balance(otherReceiver))
+
+	// a deposit above the window limit is credited in parts, as much as each window allows
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	deposit(ctx, otherReceiver, 250)
+	k.ReleaseQueuedDeposits(ctx)
+	require.Equal(t, int64(130), balance(otherReceiver))
+	res, err = k.QueuedDepositsByReceiver(sdk.WrapSDKContext(ctx), &types.QueryQueuedDepositsByReceiverRequest{Receiver: otherReceiver.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Deposits, 1)
+	require.Equal(t, sdk.NewInt(150), res.Deposits[0].Deposit.Amount)
+
+	k.ReleaseQueuedDeposits(ctx)
+	require.Equal(t, int64(130), balance(otherReceiver))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.ReleaseQueuedDeposits(ctx)
+	require.Equal(t, int64(230), balance(otherReceiver))
+
+	// the rest is within the window limit and credited in full once it fits
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.ReleaseQueuedDeposits(ctx)
+	require.Empty(t, k.GetQueuedDeposits(ctx))
+	require.Equal(t, int64(280), balance(otherReceiver))
+	res, err = k.QueuedDepositsByReceiver(sdk.WrapSDKContext(ctx), &types.QueryQueuedDepositsByReceiverRequest{Receiver: otherReceiver.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Deposits)
+
+	// governance can cancel a queued deposit
+	deposit(ctx, myReceiver, 80)
+	require.Len(t, k.GetQueuedDeposits(ctx), 1)
+	require.Error(t, k.CancelQueuedDeposit(ctx, nonce+1))
+	require.NoError(t, k.CancelQueuedDeposit(ctx, nonce))
+	require.Empty(t, k.GetQueuedDeposits(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.ReleaseQueuedDeposits(ctx)
+	require.Equal(t, int64(120), balance(myReceiver))
+}
+
+func TestReleaseQueuedDepositsPerToken(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		myReceiver          = AccAddrs[0]
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenContract  = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+		nonce               uint64
+	)
+	deposit := func(tokenContract string, amount int64) {
+		nonce++
+		claim := &types.MsgDepositClaim{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: myReceiver.String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+	balance := func(tokenContract string) int64 {
+		denom := types.NewERC20Token(1, tokenContract).GravityCoin().Denom
+		return input.BankKeeper.GetBalance(ctx, myReceiver, denom).Amount.Int64()
+	}
+
+	params := k.GetParams(ctx)
+	params.InboundDepositsPaused = true
+	params.InboundRateLimitWindow = 10
+	params.InboundRateLimits = []types.InboundRateLimit{{WindowLimit: sdk.NewInt(100)}}
+	k.SetParams(ctx, params)
+	deposit(myTokenContractAddr, 80)
+	deposit(myTokenContractAddr, 50)
+	deposit(otherTokenContract, 30)
+	deposit(myTokenContractAddr, 10)
+	require.Len(t, k.GetQueuedDeposits(ctx), 4)
+
+	// a token that has to wait doesn't hold up the others
+	params.InboundDepositsPaused = false
+	k.SetParams(ctx, params)
+	k.ReleaseQueuedDeposits(ctx)
+	require.Equal(t, int64(80), balance(myTokenContractAddr))
+	require.Equal(t, int64(30), balance(otherTokenContract))
+	queued := k.GetQueuedDeposits(ctx)
+	require.Len(t, queued, 2)
+	require.Equal(t, uint64(2), queued[0].Deposit.EventNonce)
+	require.Equal(t, uint64(4), queued[1].Deposit.EventNonce)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+// GetValsetSignatures returns the signatures needed to relay the valset with the given
+// nonce to Ethereum
+func (k Keeper) GetValsetSignatures(ctx sdk.Context, nonce uint64) *types.RelaySignatures {
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetValsetConfirms(ctx, nonce) {
+		signatures[strings.ToLower(confirm.EthAddress)] = confirm.Signature
+	}
+	return k.getRelaySignatures(ctx, signatures)
+}
+
+// GetBatchSignatures returns the signatures needed to relay a batch to Ethereum
+func (k Keeper) GetBatchSignatures(ctx sdk.Context, tokenContract string, nonce uint64) *types.RelaySignatures {
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, nonce, tokenContract) {
+		signatures[strings.ToLower(confirm.EthSigner)] = confirm.Signature
+	}
+	return k.getRelaySignatures(ctx, signatures)
+}
+
+// GetLogicCallSignatures returns the signatures needed to relay a logic call to Ethereum
+func (k Keeper) GetLogicCallSignatures(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) *types.RelaySignatures {
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, invalidationNonce) {
+		signatures[strings.ToLower(confirm.EthSigner)] = confirm.Signature
+	}
+	return k.getRelaySignatures(ctx, signatures)
+}
+
+// getRelaySignatures orders signatures by Ethereum address like the members of the valset
+// the Gravity.sol contract holds and adds up the power of the members that have signed
+func (k Keeper) getRelaySignatures(ctx sdk.Context, signatures map[string]string) *types.RelaySignatures {
+	valset := k.getRelaySignerValset(ctx)
+	out := &types.RelaySignatures{CurrentValset: valset}
+	if valset == nil {
+		return out
+	}
+	for _, member := range valset.Members {
+		signature := types.RelaySignature{EthereumAddress: member.EthereumAddress, Power: member.Power}
+		// signatures are validated when they are submitted, anything that doesn't
+		// decode is left out like a missing signature
+		if bz, err := hex.DecodeString(signatures[strings.ToLower(member.EthereumAddress)]); err == nil {
+			if v, r, s, err := types.SplitEthereumSignature(bz); err == nil {
+				signature.V, signature.R, signature.S = uint32(v), hexutil.Encode(r), hexutil.Encode(s)
+				out.SignedPower += member.Power
+			}
+		}
+		out.Signatures = append(out.Signatures, signature)
+	}
+	out.ThresholdMet = out.SignedPower > types.BridgePowerThreshold
+	return out
+}
+
+// getRelaySignerValset returns the valset the Gravity.sol contract holds, whose members
+// have to sign everything relayed to it. Until a valset update has been observed the
+// contract holds the valset it was deployed with, which is taken to be the first valset
+func (k Keeper) getRelaySignerValset(ctx sdk.Context) *types.Valset {
+	if valset := k.GetLastObservedValset(ctx); valset != nil {
+		return valset
+	}
+	// valsets are only pruned once an update has been observed
+	valsets := k.GetValsets(ctx)
+	if len(valsets) == 0 {
+		return nil
+	}
+	return valsets[len(valsets)-1]
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestRelaySignatures(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	// r, s and a v in go-ethereum's format
+	signature := func(i int) string {
+		return hex.EncodeToString(append(append(bytes.Repeat([]byte{byte(i + 1)}, 32), bytes.Repeat([]byte{0xff}, 32)...), byte(i%2)))
+	}
+
+	valset := k.SetValsetRequest(ctx)
+	for i := 0; i < 3; i++ {
+		k.SetValsetConfirm(ctx, ValAddrs[i], *types.NewMsgValsetConfirm(valset.Nonce, EthAddrs[i].String(), AccAddrs[i], signature(i)))
+	}
+
+	// until an update is observed the first valset signs, 3 of 5 members are not enough
+	res, err := k.ValsetSignatures(sdk.WrapSDKContext(ctx), &types.QueryValsetSignaturesRequest{Nonce: valset.Nonce})
+	require.NoError(t, err)
+	require.Equal(t, valset, res.Valset)
+	sigs := res.Signatures
+	require.Equal(t, valset.Nonce, sigs.CurrentValset.Nonce)
+	require.Len(t, sigs.Signatures, len(valset.Members))
+	assert.False(t, sigs.ThresholdMet)
+	for i, sig := range sigs.Signatures {
+		assert.Equal(t, valset.Members[i].EthereumAddress, sig.EthereumAddress)
+		assert.Equal(t, valset.Members[i].Power, sig.Power)
+	}
+	for i := 0; i < 3; i++ {
+		sig := signatureOf(t, sigs, EthAddrs[i].String())
+		assert.Equal(t, uint32(27+i%2), sig.V)
+		assert.Equal(t, hexutil.Encode(bytes.Repeat([]byte{byte(i + 1)}, 32)), sig.R)
+		assert.Equal(t, hexutil.Encode(bytes.Repeat([]byte{0xff}, 32)), sig.S)
+	}
+	missing := signatureOf(t, sigs, EthAddrs[3].String())
+	assert.Equal(t, uint32(0), missing.V)
+	assert.Empty(t, missing.R)
+	assert.Empty(t, missing.S)
+
+	k.SetValsetConfirm(ctx, ValAddrs[3], *types.NewMsgValsetConfirm(valset.Nonce, EthAddrs[3].String(), AccAddrs[3], signature(3)))
+	sigs = k.GetValsetSignatures(ctx, valset.Nonce)
+	assert.True(t, sigs.ThresholdMet)
+	assert.Equal(t, 4*valset.Members[0].Power, sigs.SignedPower)
+
+	// signatures follow the member order of the last observed valset
+	observed := types.Valset{Nonce: 7}
+	for i := len(EthAddrs) - 1; i >= 0; i-- {
+		observed.Members = append(observed.Members, &types.BridgeValidator{EthereumAddress: EthAddrs[i].String(), Power: 1000000000})
+	}
+	k.SetLastObservedValset(ctx, observed)
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	for i := 0; i < 2; i++ {
+		k.SetBatchConfirm(ctx, ValAddrs[i], &types.MsgConfirmBatch{
+			Nonce:         1,
+			TokenContract: tokenContract,
+			EthSigner:     EthAddrs[i].String(),
+			Orchestrator:  AccAddrs[i].String(),
+			Signature:     signature(i),
+		})
+	}
+	sigs = k.GetBatchSignatures(ctx, tokenContract, 1)
+	require.Equal(t, uint64(7), sigs.CurrentValset.Nonce)
+	require.Len(t, sigs.Signatures, len(EthAddrs))
+	assert.Equal(t, EthAddrs[len(EthAddrs)-1].String(), sigs.Signatures[0].EthereumAddress)
+	assert.Equal(t, uint32(0), sigs.Signatures[0].V)
+	assert.Equal(t, uint32(27), sigs.Signatures[len(EthAddrs)-1].V)
+	assert.Equal(t, uint64(2000000000), sigs.SignedPower)
+	assert.False(t, sigs.ThresholdMet)
+
+	// unknown batches and logic calls are rejected
+	_, err = k.BatchSignatures(sdk.WrapSDKContext(ctx), &types.QueryBatchSignaturesRequest{TokenContract: tokenContract, Nonce: 1})
+	require.Error(t, err)
+	_, err = k.LogicCallSignatures(sdk.WrapSDKContext(ctx), &types.QueryLogicCallSignaturesRequest{InvalidationId: []byte{1}, InvalidationNonce: 1})
+	require.Error(t, err)
+}
+
+func signatureOf(t *testing.T, sigs *types.RelaySignatures, ethAddress string) types.RelaySignature {
+	for _, sig := range sigs.Signatures {
+		if sig.EthereumAddress == ethAddress {
+			return sig
+		}
+	}
+	t.Fatalf("no signature for %s", ethAddress)
+	return types.RelaySignature{}
+}
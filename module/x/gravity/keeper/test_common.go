@@ -182,22 +182,31 @@ var (
 
 	// TestingGravityParams is a set of gravity params for testing
 	TestingGravityParams = types.Params{
-		GravityId:                     "testgravityid",
-		ContractSourceHash:            "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:         "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                 11,
-		SignedBatchesWindow:           10,
-		SignedValsetsWindow:           10,
-		UnbondSlashingValsetsWindow:   15,
-		SignedClaimsWindow:            10,
-		TargetBatchTimeout:            60001,
-		AverageBlockTime:              5000,
-		AverageEthereumBlockTime:      15000,
-		SlashFractionValset:           sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:            sdk.NewDecWithPrec(1, 2),
-		SlashFractionClaim:            sdk.NewDecWithPrec(1, 2),
-		SlashFractionConflictingClaim: sdk.NewDecWithPrec(1, 2),
-		SlashFractionBadEthSignature:  sdk.NewDecWithPrec(1, 2),
+		GravityId:                      "testgravityid",
+		ContractSourceHash:             "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:          "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                  11,
+		SignedBatchesWindow:            10,
+		SignedValsetsWindow:            10,
+		UnbondSlashingValsetsWindow:    15,
+		SignedClaimsWindow:             10,
+		TargetBatchTimeout:             60001,
+		AverageBlockTime:               5000,
+		AverageEthereumBlockTime:       15000,
+		SlashFractionValset:            sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:             sdk.NewDecWithPrec(1, 2),
+		SlashFractionClaim:             sdk.NewDecWithPrec(1, 2),
+		SlashFractionConflictingClaim:  sdk.NewDecWithPrec(1, 2),
+		SlashFractionBadEthSignature:   sdk.NewDecWithPrec(1, 2),
+		SignedLogicCallsWindow:         10,
+		SlashFractionLogicCall:         sdk.NewDecWithPrec(1, 2),
+		AttestationVotesPowerThreshold: sdk.NewDecWithPrec(66, 2),
+		ValsetPowerChangeThreshold:     sdk.NewDecWithPrec(5, 2),
+		ObservedValsetsWindow:          1000,
+		MaxOrchestratorsPerValidator:   3,
+		BatchSize:                      100,
+		EthKeyRotationTimeout:          1000,
+		OutboundGlobalWindowLimit:      sdk.ZeroInt(),
 	}
 )
 
@@ -213,10 +222,11 @@ type TestInput struct {
 	Context        sdk.Context
 	Marshaler      codec.Marshaler
 	LegacyAmino    *codec.LegacyAmino
+	ParamsStoreKey *sdk.KVStoreKey
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
-func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
+func SetupFiveValChain(t testing.TB) (TestInput, sdk.Context) {
 	t.Helper()
 	input := CreateTestEnv(t)
 
@@ -263,7 +273,7 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -425,6 +435,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		Context:        ctx,
 		Marshaler:      marshaler,
 		LegacyAmino:    cdc,
+		ParamsStoreKey: keyParams,
 	}
 }
 
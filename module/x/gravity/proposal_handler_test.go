@@ -0,0 +1,35 @@
+package gravity
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gravity-bridge/module/x/gravity/keeper"
+	"github.com/cosmos/gravity-bridge/module/x/gravity/types"
+)
+
+func TestCancelQueuedDepositProposal(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	h := NewGravityProposalHandler(input.GravityKeeper)
+
+	input.GravityKeeper.SetQueuedDeposit(ctx, types.QueuedDeposit{
+		Deposit: types.MsgDepositClaim{
+			EventNonce:     7,
+			TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Amount:         sdk.NewInt(100),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+		},
+		QueuedHeight: 1,
+	})
+
+	proposal := types.NewCancelQueuedDepositProposal("cancel", "oracle compromise", 7)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, h(ctx, proposal))
+	require.Empty(t, input.GravityKeeper.GetQueuedDeposits(ctx))
+
+	// it's gone
+	require.Error(t, h(ctx, proposal))
+}
@@ -99,13 +99,15 @@ func (m *OutgoingTxBatch) GetBlock() uint64 {
 	return 0
 }
 
-// OutgoingTransferTx represents an individual send from gravity to ETH
+// OutgoingTransferTx represents an individual send from gravity to ETH, BLOCK
+// is the Cosmos block height at which it was added to the pool
 type OutgoingTransferTx struct {
 	Id          uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Sender      string      `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 	DestAddress string      `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
 	Erc20Token  *ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token,omitempty"`
 	Erc20Fee    *ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee,omitempty"`
+	Block       uint64      `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
@@ -176,6 +178,13 @@ func (m *OutgoingTransferTx) GetErc20Fee() *ERC20Token {
 	return nil
 }
 
+func (m *OutgoingTransferTx) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
 // OutgoingLogicCall represents an individual logic call from gravity to ETH
 type OutgoingLogicCall struct {
 	Transfers            []*ERC20Token `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
@@ -185,6 +194,7 @@ type OutgoingLogicCall struct {
 	Timeout              uint64        `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	InvalidationId       []byte        `protobuf:"bytes,6,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
 	InvalidationNonce    uint64        `protobuf:"varint,7,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	Block                uint64        `protobuf:"varint,8,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
@@ -269,6 +279,13 @@ func (m *OutgoingLogicCall) GetInvalidationNonce() uint64 {
 	return 0
 }
 
+func (m *OutgoingLogicCall) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
@@ -278,39 +295,40 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
 
 var fileDescriptor_4453b445b0660cab = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6a, 0xdb, 0x4e,
-	0x10, 0xc7, 0x2d, 0xc7, 0x71, 0x7e, 0x1e, 0x3b, 0x0e, 0x59, 0x82, 0x11, 0x3f, 0x8a, 0xea, 0xba,
-	0x94, 0x9a, 0x42, 0xac, 0xc4, 0x09, 0xe4, 0x5c, 0x9b, 0x16, 0x0a, 0xa5, 0xa5, 0xc2, 0xa7, 0x5e,
-	0xc4, 0x6a, 0x77, 0xad, 0x2c, 0x91, 0xb5, 0x46, 0xbb, 0x36, 0xf6, 0x5b, 0xf4, 0x6d, 0xfa, 0x0a,
-	0xbd, 0x14, 0x72, 0xcc, 0xb1, 0xd8, 0x2f, 0x52, 0x76, 0x24, 0x25, 0x4e, 0x4b, 0x7a, 0xd3, 0x7c,
-	0xe6, 0x3b, 0x7f, 0x77, 0x04, 0x9d, 0x38, 0xa3, 0x4b, 0x69, 0xd6, 0xfe, 0xf2, 0xdc, 0x8f, 0xa8,
-	0x61, 0xd7, 0x83, 0x79, 0xa6, 0x8c, 0x22, 0x50, 0xf0, 0xc1, 0xf2, 0xfc, 0xff, 0x67, 0x3b, 0x1a,
-	0x6a, 0x8c, 0xd0, 0x86, 0x1a, 0xa9, 0xd2, 0x5c, 0xd9, 0xbb, 0x73, 0xe0, 0xe8, 0xf3, 0xc2, 0xc4,
-	0x4a, 0xa6, 0xf1, 0x64, 0x35, 0xb2, 0x39, 0xc8, 0x73, 0x68, 0x62, 0xb2, 0x30, 0x55, 0x29, 0x13,
-	0xae, 0xd3, 0x75, 0xfa, 0xb5, 0x00, 0x10, 0x7d, 0xb2, 0x84, 0xbc, 0x84, 0xc3, 0x5c, 0x60, 0xe4,
-	0x4c, 0xa8, 0x85, 0x71, 0xab, 0x28, 0x69, 0x21, 0x9c, 0xe4, 0x8c, 0x8c, 0xa0, 0x65, 0x32, 0x9a,
-	0x6a, 0xca, 0x6c, 0x39, 0xed, 0xee, 0x75, 0xf7, 0xfa, 0xcd, 0xa1, 0x37, 0x78, 0x68, 0x6d, 0x70,
-	0x5f, 0xd8, 0xea, 0xa6, 0x22, 0x9b, 0xac, 0x82, 0x47, 0x31, 0xe4, 0x15, 0xb4, 0x8d, 0xba, 0x11,
-	0x69, 0xc8, 0x54, 0x6a, 0x32, 0xca, 0x8c, 0x5b, 0xeb, 0x3a, 0xfd, 0x46, 0x70, 0x88, 0x74, 0x5c,
-	0x40, 0x72, 0x02, 0xfb, 0x51, 0xa2, 0xd8, 0x8d, 0xbb, 0x8f, 0x7d, 0xe4, 0x46, 0xef, 0xa7, 0x03,
-	0xe4, 0xef, 0x0a, 0xa4, 0x0d, 0x55, 0xc9, 0x8b, 0xa1, 0xaa, 0x92, 0x93, 0x0e, 0xd4, 0xb5, 0x48,
-	0xb9, 0xc8, 0x70, 0x8a, 0x46, 0x50, 0x58, 0xe4, 0x05, 0xb4, 0xb8, 0xd0, 0x26, 0xa4, 0x9c, 0x67,
-	0x42, 0xdb, 0xfe, 0xad, 0xb7, 0x69, 0xd9, 0xdb, 0x1c, 0x91, 0x2b, 0x68, 0x8a, 0x8c, 0x0d, 0xcf,
-	0x42, 0x6c, 0x07, 0x7b, 0x6b, 0x0e, 0x3b, 0xbb, 0x13, 0xbe, 0x0b, 0xc6, 0xc3, 0xb3, 0x89, 0xf5,
-	0x06, 0x80, 0x52, 0xfc, 0x26, 0x17, 0xd0, 0xc8, 0x03, 0xa7, 0x42, 0x60, 0xd3, 0x4f, 0x87, 0xfd,
-	0x87, 0xc2, 0xf7, 0x42, 0xf4, 0xbe, 0x57, 0xe1, 0xb8, 0x9c, 0xe7, 0xa3, 0x8a, 0x25, 0x1b, 0xd3,
-	0x24, 0x21, 0x97, 0xd0, 0x30, 0xc5, 0x70, 0xda, 0x75, 0x70, 0xc7, 0x4f, 0xa5, 0x7a, 0x10, 0x92,
-	0x37, 0x50, 0x9b, 0x0a, 0xa1, 0xdd, 0xea, 0x3f, 0x03, 0x50, 0x43, 0x2e, 0xa1, 0x93, 0xd8, 0x72,
-	0xf7, 0x8f, 0xf0, 0xc7, 0x4a, 0x4e, 0xd0, 0x5b, 0x3e, 0x46, 0xb9, 0x1b, 0x17, 0x0e, 0xe6, 0x74,
-	0x9d, 0x28, 0xca, 0x71, 0x2f, 0xad, 0xa0, 0x34, 0xad, 0xa7, 0xbc, 0x9b, 0xfc, 0xbd, 0x4a, 0x93,
-	0xbc, 0x86, 0x23, 0x99, 0x2e, 0x69, 0x22, 0x39, 0x9e, 0x68, 0x28, 0xb9, 0x5b, 0xc7, 0xd8, 0xf6,
-	0x2e, 0xfe, 0xc0, 0xc9, 0x29, 0x90, 0x47, 0xc2, 0xfc, 0x50, 0x0f, 0x30, 0xdb, 0xf1, 0xae, 0x07,
-	0xef, 0x75, 0xf4, 0xe5, 0xc7, 0xc6, 0x73, 0x6e, 0x37, 0x9e, 0xf3, 0x6b, 0xe3, 0x39, 0xdf, 0xb6,
-	0x5e, 0xe5, 0x76, 0xeb, 0x55, 0xee, 0xb6, 0x5e, 0xe5, 0xeb, 0x55, 0x2c, 0xcd, 0xf5, 0x22, 0x1a,
-	0x30, 0x35, 0xf3, 0x99, 0xd2, 0x33, 0xa5, 0xfd, 0x62, 0x15, 0xa7, 0x51, 0x26, 0x79, 0x2c, 0xfc,
-	0x99, 0xe2, 0x8b, 0x44, 0xf8, 0xab, 0x92, 0xfb, 0x66, 0x3d, 0x17, 0x3a, 0xaa, 0xe3, 0xef, 0x73,
-	0xf1, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xa1, 0x12, 0x22, 0x82, 0x03, 0x00, 0x00,
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0xb5, 0xfc, 0x95, 0x78, 0xec, 0x38, 0x64, 0x09, 0x46, 0x94, 0xa2, 0xba, 0x29, 0xa5, 0xa6,
+	0x10, 0x29, 0x71, 0x02, 0x39, 0xd7, 0xa6, 0x85, 0x42, 0x69, 0xa9, 0xf0, 0xa9, 0x17, 0xb1, 0xda,
+	0x5d, 0x2b, 0x4b, 0x64, 0xad, 0xd1, 0xae, 0x8d, 0xfd, 0x2f, 0xfa, 0xb3, 0x7a, 0x29, 0xe4, 0x98,
+	0x63, 0xb1, 0xff, 0x48, 0xd9, 0x95, 0x64, 0x2b, 0x2d, 0xf8, 0xa6, 0x79, 0xf3, 0x66, 0x67, 0xde,
+	0x9b, 0x11, 0xf4, 0xa2, 0x14, 0x2f, 0xb9, 0x5a, 0x7b, 0xcb, 0x6b, 0x2f, 0xc4, 0x8a, 0xdc, 0xbb,
+	0xf3, 0x54, 0x28, 0x81, 0x20, 0xc7, 0xdd, 0xe5, 0xf5, 0x8b, 0x97, 0x25, 0x0e, 0x56, 0x8a, 0x49,
+	0x85, 0x15, 0x17, 0x49, 0xc6, 0xbc, 0x78, 0xb2, 0xe0, 0xf4, 0xdb, 0x42, 0x45, 0x82, 0x27, 0xd1,
+	0x64, 0x35, 0xd2, 0x6f, 0xa0, 0x57, 0xd0, 0x36, 0x8f, 0x05, 0x89, 0x48, 0x08, 0xb3, 0xad, 0xbe,
+	0x35, 0xa8, 0xfb, 0x60, 0xa0, 0xaf, 0x1a, 0x41, 0x6f, 0xe0, 0x24, 0x23, 0x28, 0x3e, 0x63, 0x62,
+	0xa1, 0xec, 0xaa, 0xa1, 0x74, 0x0c, 0x38, 0xc9, 0x30, 0x34, 0x82, 0x8e, 0x4a, 0x71, 0x22, 0x31,
+	0xd1, 0xed, 0xa4, 0x5d, 0xeb, 0xd7, 0x06, 0xed, 0xa1, 0xe3, 0xee, 0x47, 0x73, 0x77, 0x8d, 0x35,
+	0x6f, 0xca, 0xd2, 0xc9, 0xca, 0x7f, 0x56, 0x83, 0xde, 0x42, 0x57, 0x89, 0x07, 0x96, 0x04, 0x44,
+	0x24, 0x2a, 0xc5, 0x44, 0xd9, 0xf5, 0xbe, 0x35, 0x68, 0xf9, 0x27, 0x06, 0x1d, 0xe7, 0x20, 0x3a,
+	0x87, 0x46, 0x18, 0x0b, 0xf2, 0x60, 0x37, 0xcc, 0x1c, 0x59, 0x70, 0xb1, 0xb5, 0x00, 0xfd, 0xdf,
+	0x01, 0x75, 0xa1, 0xca, 0x69, 0x2e, 0xaa, 0xca, 0x29, 0xea, 0x41, 0x53, 0xb2, 0x84, 0xb2, 0xd4,
+	0xa8, 0x68, 0xf9, 0x79, 0x84, 0x5e, 0x43, 0x87, 0x32, 0xa9, 0x02, 0x4c, 0x69, 0xca, 0xa4, 0x9e,
+	0x5f, 0x67, 0xdb, 0x1a, 0xfb, 0x90, 0x41, 0xe8, 0x0e, 0xda, 0x2c, 0x25, 0xc3, 0xab, 0xc0, 0x8c,
+	0x63, 0x66, 0x6b, 0x0f, 0x7b, 0x65, 0x85, 0x1f, 0xfd, 0xf1, 0xf0, 0x6a, 0xa2, 0xb3, 0x3e, 0x18,
+	0xaa, 0xf9, 0x46, 0x37, 0xd0, 0xca, 0x0a, 0xa7, 0x8c, 0xd9, 0x8d, 0x83, 0x65, 0xc7, 0x86, 0xf8,
+	0x89, 0xb1, 0xbd, 0xca, 0x66, 0x59, 0xe5, 0xef, 0x2a, 0x9c, 0x15, 0x2a, 0xbf, 0x88, 0x88, 0x93,
+	0x31, 0x8e, 0x63, 0x74, 0x0b, 0x2d, 0x95, 0x4b, 0x96, 0xb6, 0xd5, 0xaf, 0x1d, 0x68, 0xb0, 0x27,
+	0xa2, 0xf7, 0x50, 0x9f, 0x32, 0x26, 0xed, 0xea, 0xc1, 0x02, 0xc3, 0x41, 0xb7, 0xd0, 0x8b, 0x75,
+	0xbb, 0xdd, 0x6a, 0xfe, 0x31, 0xea, 0xdc, 0x64, 0x8b, 0x15, 0x15, 0x8e, 0xd9, 0x70, 0x34, 0xc7,
+	0xeb, 0x58, 0x60, 0x6a, 0xdc, 0xea, 0xf8, 0x45, 0xa8, 0x33, 0xc5, 0x35, 0x65, 0x5b, 0x2c, 0x42,
+	0xf4, 0x0e, 0x4e, 0x79, 0xb2, 0xc4, 0x31, 0xa7, 0xe6, 0x70, 0x03, 0x4e, 0x8d, 0x03, 0x1d, 0xbf,
+	0x5b, 0x86, 0x3f, 0x53, 0x74, 0x09, 0xe8, 0x19, 0x31, 0x3b, 0xdf, 0x23, 0xf3, 0xda, 0x59, 0x39,
+	0x93, 0x5d, 0xf1, 0xce, 0xcf, 0xe3, 0x92, 0x9f, 0xa3, 0xef, 0xbf, 0x36, 0x8e, 0xf5, 0xb8, 0x71,
+	0xac, 0x3f, 0x1b, 0xc7, 0xfa, 0xb9, 0x75, 0x2a, 0x8f, 0x5b, 0xa7, 0xf2, 0xb4, 0x75, 0x2a, 0x3f,
+	0xee, 0x22, 0xae, 0xee, 0x17, 0xa1, 0x4b, 0xc4, 0xcc, 0x23, 0x42, 0xce, 0x84, 0xf4, 0x72, 0x83,
+	0x2e, 0xc3, 0x94, 0xd3, 0x88, 0x79, 0x33, 0x41, 0x17, 0x31, 0xf3, 0x56, 0x05, 0xee, 0xa9, 0xf5,
+	0x9c, 0xc9, 0xb0, 0x69, 0x7e, 0xb5, 0x9b, 0xbf, 0x03, 0x00, 0x1b, 0x3e, 0x42, 0x75, 0xae, 0x03,
+	0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -392,6 +410,11 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Block != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Erc20Fee != nil {
 		{
 			size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
@@ -458,6 +481,11 @@ func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Block != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.InvalidationNonce != 0 {
 		i = encodeVarintBatch(dAtA, i, uint64(m.InvalidationNonce))
 		i--
@@ -584,6 +612,9 @@ func (m *OutgoingTransferTx) Size() (n int) {
 		l = m.Erc20Fee.Size()
 		n += 1 + l + sovBatch(uint64(l))
 	}
+	if m.Block != 0 {
+		n += 1 + sovBatch(uint64(m.Block))
+	}
 	return n
 }
 
@@ -623,6 +654,9 @@ func (m *OutgoingLogicCall) Size() (n int) {
 	if m.InvalidationNonce != 0 {
 		n += 1 + sovBatch(uint64(m.InvalidationNonce))
 	}
+	if m.Block != 0 {
+		n += 1 + sovBatch(uint64(m.Block))
+	}
 	return n
 }
 
@@ -992,6 +1026,25 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -1251,6 +1304,25 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -29,7 +30,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
+		&MsgIncreaseBridgeFee{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgRotateEthKey{},
+		&MsgAddOrchestratorAddress{},
+		&MsgRemoveOrchestratorAddress{},
 	)
 
 	registry.RegisterInterface(
@@ -42,6 +47,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&CancelQueuedDepositProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -62,9 +71,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgValsetUpdatedClaim{}, "gravity/MsgValsetUpdatedClaim", nil)
 	cdc.RegisterConcrete(&OutgoingTxBatch{}, "gravity/OutgoingTxBatch", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEth{}, "gravity/MsgCancelSendToEth", nil)
+	cdc.RegisterConcrete(&MsgIncreaseBridgeFee{}, "gravity/MsgIncreaseBridgeFee", nil)
 	cdc.RegisterConcrete(&OutgoingTransferTx{}, "gravity/OutgoingTransferTx", nil)
 	cdc.RegisterConcrete(&ERC20Token{}, "gravity/ERC20Token", nil)
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgRotateEthKey{}, "gravity/MsgRotateEthKey", nil)
+	cdc.RegisterConcrete(&MsgAddOrchestratorAddress{}, "gravity/MsgAddOrchestratorAddress", nil)
+	cdc.RegisterConcrete(&MsgRemoveOrchestratorAddress{}, "gravity/MsgRemoveOrchestratorAddress", nil)
 }
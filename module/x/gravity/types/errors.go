@@ -14,4 +14,8 @@ var (
 	ErrOutdated                = sdkerrors.Register(ModuleName, 7, "outdated")
 	ErrUnsupported             = sdkerrors.Register(ModuleName, 8, "unsupported")
 	ErrNonContiguousEventNonce = sdkerrors.Register(ModuleName, 9, "non contiguous event nonce")
+	ErrBridgeHalted            = sdkerrors.Register(ModuleName, 10, "bridge halted")
+	ErrBridgePaused            = sdkerrors.Register(ModuleName, 11, "bridge paused")
+	ErrRateLimitExceeded       = sdkerrors.Register(ModuleName, 12, "outbound rate limit exceeded")
+	ErrBatchBelowThreshold     = sdkerrors.Register(ModuleName, 13, "batch below threshold")
 )
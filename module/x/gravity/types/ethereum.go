@@ -24,9 +24,10 @@ const (
 	GravityDenomLen = len(GravityDenomPrefix) + len(GravityDenomSeparator) + ETHContractAddressLen
 )
 
-// EthAddrLessThan migrates the Ethereum address less than function
+// EthAddrLessThan migrates the Ethereum address less than function, it compares the address
+// bytes so that the case of checksummed addresses doesn't change the order
 func EthAddrLessThan(e, o string) bool {
-	return bytes.Compare([]byte(e)[:], []byte(o)[:]) == -1
+	return bytes.Compare([]byte(strings.ToLower(e)), []byte(strings.ToLower(o))) == -1
 }
 
 // ValidateEthAddress validates the ethereum address strings
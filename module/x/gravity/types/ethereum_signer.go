@@ -2,13 +2,20 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
 	signaturePrefix = "\x19Ethereum Signed Message:\n32"
+
+	// BridgePowerThreshold is the power threshold the Gravity.sol contract is deployed
+	// with by contract-deployer.ts, 66% of the normalized valset power. The contract
+	// only accepts signatures of members whose power adds up to more than this
+	BridgePowerThreshold uint64 = 2834678415
 )
 
 // NewEthereumSignature creates a new signuature over a given byte array
@@ -63,9 +70,37 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress string)
 		return sdkerrors.Wrap(err, "")
 	}
 
-	if addr != ethAddress {
+	// the address may be given in lower case or checksummed
+	if !strings.EqualFold(addr, ethAddress) {
 		return sdkerrors.Wrap(ErrInvalid, "signature not matching")
 	}
 
 	return nil
 }
+
+// SplitEthereumSignature splits a signature into the v, r and s values the Gravity.sol
+// contract takes, with v presented as 27 or 28
+func SplitEthereumSignature(signature []byte) (v uint8, r, s []byte, err error) {
+	if len(signature) != 65 {
+		return 0, nil, nil, sdkerrors.Wrap(ErrInvalid, "signature length")
+	}
+	v = signature[64]
+	if v < 27 {
+		v += 27
+	}
+	return v, signature[:32], signature[32:64], nil
+}
+
+// GetEthKeyRotationHash returns the hash a new Ethereum key signs to prove that it is held by
+// the validator rotating to it, the gravity id keeps the signature from being replayed on
+// another bridge
+func GetEthKeyRotationHash(gravityID string, validator sdk.ValAddress) []byte {
+	return crypto.Keccak256([]byte(gravityID), validator.Bytes())
+}
+
+// GetDelegateKeysHash returns the hash an Ethereum key signs to prove that it is held by the
+// validator setting its delegate keys. The gravity id keeps the signature from being replayed
+// on another chain and the nonce from being replayed on this one
+func GetDelegateKeysHash(gravityID string, validator sdk.ValAddress, orchestrator sdk.AccAddress, nonce uint64) []byte {
+	return crypto.Keccak256([]byte(gravityID), validator.Bytes(), orchestrator.Bytes(), UInt64Bytes(nonce))
+}
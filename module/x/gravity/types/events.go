@@ -9,6 +9,14 @@ const (
 	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
 	EventTypeBridgeDepositReceived     = "deposit_received"
 	EventTypeBridgeWithdrawCanceled    = "withdraw_canceled"
+	EventTypeBridgeFeeIncreased        = "bridge_fee_increased"
+	EventTypeConflictingClaim          = "conflicting_claim"
+	EventTypeBridgeHijack              = "bridge_hijack"
+	EventTypeDepositQueued             = "deposit_queued"
+	EventTypeQueuedDepositCanceled     = "queued_deposit_canceled"
+	EventTypeValidatorsMissingEthKeys  = "validators_missing_eth_keys"
+	EventTypeEthKeyRotated             = "eth_key_rotated"
+	EventTypeEthKeyRotationCompleted   = "eth_key_rotation_completed"
 
 	AttributeKeyAttestationID          = "attestation_id"
 	AttributeKeyBatchConfirmKey        = "batch_confirm_key"
@@ -27,4 +35,10 @@ const (
 	AttributeKeyInvalidationNonce      = "logic_call_invalidation_nonce"
 	AttributeKeyBadEthSignature        = "bad_eth_signature"
 	AttributeKeyBadEthSignatureSubject = "bad_eth_signature_subject"
+	AttributeKeyValidator              = "validator"
+	AttributeKeyObservedClaimHash      = "observed_claim_hash"
+	AttributeKeyConflictingClaimHash   = "conflicting_claim_hash"
+	AttributeKeyEthAddress             = "eth_address"
+	AttributeKeyPreviousEthAddress     = "previous_eth_address"
+	AttributeKeyBridgeFee              = "bridge_fee"
 )
@@ -19,9 +19,6 @@ const (
 )
 
 var (
-	// AttestationVotesPowerThreshold threshold of votes power to succeed
-	AttestationVotesPowerThreshold = sdk.NewInt(66)
-
 	// ParamsStoreKeyGravityID stores the gravity id
 	ParamsStoreKeyGravityID = []byte("GravityID")
 
@@ -70,6 +67,72 @@ var (
 	// ParamStoreUnbondSlashingValsetsWindow stores unbond slashing valset window
 	ParamStoreUnbondSlashingValsetsWindow = []byte("UnbondSlashingValsetsWindow")
 
+	// ParamsStoreKeySignedLogicCallsWindow stores the signed blocks window
+	ParamsStoreKeySignedLogicCallsWindow = []byte("SignedLogicCallsWindow")
+
+	// ParamsStoreSlashFractionLogicCall stores the slash fraction LogicCall
+	ParamsStoreSlashFractionLogicCall = []byte("SlashFractionLogicCall")
+
+	// ParamsStoreKeyAttestationVotesPowerThreshold stores the fraction of votes power an attestation needs to succeed
+	ParamsStoreKeyAttestationVotesPowerThreshold = []byte("AttestationVotesPowerThreshold")
+
+	// ParamsStoreKeyBridgeHalted stores whether the bridge has been halted after a hijack
+	ParamsStoreKeyBridgeHalted = []byte("BridgeHalted")
+
+	// ParamsStoreKeyInboundDepositsPaused stores whether deposits from Ethereum are paused
+	ParamsStoreKeyInboundDepositsPaused = []byte("InboundDepositsPaused")
+
+	// ParamsStoreKeyOutboundSendsPaused stores whether sends to Ethereum are paused
+	ParamsStoreKeyOutboundSendsPaused = []byte("OutboundSendsPaused")
+
+	// ParamsStoreKeyBatchCreationPaused stores whether the creation of outgoing batches is paused
+	ParamsStoreKeyBatchCreationPaused = []byte("BatchCreationPaused")
+
+	// ParamsStoreKeyOutboundRateLimitWindow stores the number of blocks outbound rate limits apply to
+	ParamsStoreKeyOutboundRateLimitWindow = []byte("OutboundRateLimitWindow")
+
+	// ParamsStoreKeyOutboundRateLimits stores the outbound rate limits per token
+	ParamsStoreKeyOutboundRateLimits = []byte("OutboundRateLimits")
+
+	// ParamsStoreKeyOutboundGlobalWindowLimit stores the limit on the amount of all tokens together sent within the outbound rate limit window
+	ParamsStoreKeyOutboundGlobalWindowLimit = []byte("OutboundGlobalWindowLimit")
+
+	// ParamsStoreKeyInboundRateLimitWindow stores the number of blocks inbound rate limits apply to
+	ParamsStoreKeyInboundRateLimitWindow = []byte("InboundRateLimitWindow")
+
+	// ParamsStoreKeyInboundRateLimits stores the inbound rate limits per token
+	ParamsStoreKeyInboundRateLimits = []byte("InboundRateLimits")
+
+	// ParamsStoreKeyValsetPowerChangeThreshold stores the fraction of power change that triggers a new valset
+	ParamsStoreKeyValsetPowerChangeThreshold = []byte("ValsetPowerChangeThreshold")
+
+	// ParamsStoreKeyValsetMaxAge stores the number of blocks after which a new valset is created regardless of changes
+	ParamsStoreKeyValsetMaxAge = []byte("ValsetMaxAge")
+
+	// ParamsStoreKeyValsetMinSpacing stores the minimum number of blocks between two valsets
+	ParamsStoreKeyValsetMinSpacing = []byte("ValsetMinSpacing")
+
+	// ParamsStoreKeyObservedValsetsWindow stores the number of blocks a replaced observed valset is archived for
+	ParamsStoreKeyObservedValsetsWindow = []byte("ObservedValsetsWindow")
+
+	// ParamsStoreKeyMaxOrchestratorsPerValidator stores the maximum number of orchestrator keys of a validator
+	ParamsStoreKeyMaxOrchestratorsPerValidator = []byte("MaxOrchestratorsPerValidator")
+
+	// ParamsStoreKeyBatchThresholds stores the minimum fee and size of a batch per token
+	ParamsStoreKeyBatchThresholds = []byte("BatchThresholds")
+
+	// ParamsStoreKeyBatchSize stores the maximum number of transactions in a batch
+	ParamsStoreKeyBatchSize = []byte("BatchSize")
+
+	// ParamsStoreKeyTokenBatchSizes stores the maximum number of transactions in a batch per token
+	ParamsStoreKeyTokenBatchSizes = []byte("TokenBatchSizes")
+
+	// ParamsStoreKeyAutoBatchTriggers stores the conditions under which batches are built automatically per token
+	ParamsStoreKeyAutoBatchTriggers = []byte("AutoBatchTriggers")
+
+	// ParamsStoreKeyEthKeyRotationTimeout stores the number of blocks after which an Ethereum key rotation is completed
+	ParamsStoreKeyEthKeyRotationTimeout = []byte("EthKeyRotationTimeout")
+
 	// ParamStoreSlashFractionBadEthSignature stores the amount by which a validator making a fraudulent eth signature will be slashed
 	ParamStoreSlashFractionBadEthSignature = []byte("SlashFractionBadEthSignature")
 
@@ -83,6 +146,41 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	// attestations are only slashed and pruned once observed
+	if s.LastSlashedAttestationNonce > s.LastObservedNonce {
+		return sdkerrors.Wrap(ErrInvalid, "last slashed attestation nonce above last observed nonce")
+	}
+	for i, incident := range s.BridgeHijackIncidents {
+		if incident.EventNonce == 0 {
+			return sdkerrors.Wrapf(ErrEmpty, "bridge hijack incident %d event nonce", i)
+		}
+	}
+	for i, deposit := range s.QueuedDeposits {
+		if err := deposit.Deposit.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "queued deposit %d", i)
+		}
+	}
+	for i, valset := range s.Valsets {
+		if err := valset.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "valset %d", i)
+		}
+	}
+	for i, observed := range s.ObservedValsets {
+		if len(observed.Valset.Members) == 0 {
+			return sdkerrors.Wrapf(ErrEmpty, "observed valset %d members", i)
+		}
+	}
+	for i, rotation := range s.EthKeyRotations {
+		if _, err := sdk.ValAddressFromBech32(rotation.Validator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "eth key rotation %d validator", i)
+		}
+		if err := ValidateEthAddress(rotation.PreviousEthAddress); err != nil {
+			return sdkerrors.Wrapf(err, "eth key rotation %d previous eth address", i)
+		}
+		if err := ValidateEthAddress(rotation.NewEthAddress); err != nil {
+			return sdkerrors.Wrapf(err, "eth key rotation %d new eth address", i)
+		}
+	}
 	return nil
 }
 
@@ -97,19 +195,30 @@ func DefaultGenesisState() *GenesisState {
 // DefaultParams returns a copy of the default params
 func DefaultParams() *Params {
 	return &Params{
-		GravityId:                     "defaultgravityid",
-		SignedValsetsWindow:           10000,
-		SignedBatchesWindow:           10000,
-		SignedClaimsWindow:            10000,
-		TargetBatchTimeout:            43200000,
-		AverageBlockTime:              5000,
-		AverageEthereumBlockTime:      15000,
-		SlashFractionValset:           sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionClaim:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionConflictingClaim: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBadEthSignature:  sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		UnbondSlashingValsetsWindow:   10000,
+		GravityId:                      "defaultgravityid",
+		SignedValsetsWindow:            10000,
+		SignedBatchesWindow:            10000,
+		SignedClaimsWindow:             10000,
+		TargetBatchTimeout:             43200000,
+		AverageBlockTime:               5000,
+		AverageEthereumBlockTime:       15000,
+		SlashFractionValset:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBatch:             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionClaim:             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionConflictingClaim:  sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBadEthSignature:   sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		UnbondSlashingValsetsWindow:    10000,
+		SignedLogicCallsWindow:         10000,
+		SlashFractionLogicCall:         sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		AttestationVotesPowerThreshold: sdk.NewDecWithPrec(66, 2),
+		OutboundRateLimitWindow:        17280,
+		InboundRateLimitWindow:         17280,
+		ValsetPowerChangeThreshold:     sdk.NewDecWithPrec(5, 2),
+		ObservedValsetsWindow:          120960,
+		MaxOrchestratorsPerValidator:   3,
+		BatchSize:                      100,
+		EthKeyRotationTimeout:          120960,
+		OutboundGlobalWindowLimit:      sdk.ZeroInt(),
 	}
 }
 
@@ -163,6 +272,65 @@ func (p Params) ValidateBasic() error {
 	if err := validateUnbondSlashingValsetsWindow(p.UnbondSlashingValsetsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond Slashing valset window")
 	}
+	if err := validateSignedLogicCallsWindow(p.SignedLogicCallsWindow); err != nil {
+		return sdkerrors.Wrap(err, "signed logic calls window")
+	}
+	if err := validateSlashFractionLogicCall(p.SlashFractionLogicCall); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction logic call")
+	}
+	if err := validateAttestationVotesPowerThreshold(p.AttestationVotesPowerThreshold); err != nil {
+		return sdkerrors.Wrap(err, "attestation votes power threshold")
+	}
+	if err := validateOutboundRateLimitWindow(p.OutboundRateLimitWindow); err != nil {
+		return sdkerrors.Wrap(err, "outbound rate limit window")
+	}
+	if err := validateOutboundGlobalWindowLimit(p.OutboundGlobalWindowLimit); err != nil {
+		return sdkerrors.Wrap(err, "outbound global window limit")
+	}
+	if err := validateOutboundRateLimits(p.OutboundRateLimits); err != nil {
+		return sdkerrors.Wrap(err, "outbound rate limits")
+	}
+	if err := validateInboundRateLimitWindow(p.InboundRateLimitWindow); err != nil {
+		return sdkerrors.Wrap(err, "inbound rate limit window")
+	}
+	if err := validateInboundRateLimits(p.InboundRateLimits); err != nil {
+		return sdkerrors.Wrap(err, "inbound rate limits")
+	}
+	if err := validateValsetPowerChangeThreshold(p.ValsetPowerChangeThreshold); err != nil {
+		return sdkerrors.Wrap(err, "valset power change threshold")
+	}
+	if err := validateValsetMaxAge(p.ValsetMaxAge); err != nil {
+		return sdkerrors.Wrap(err, "valset max age")
+	}
+	if err := validateValsetMinSpacing(p.ValsetMinSpacing); err != nil {
+		return sdkerrors.Wrap(err, "valset min spacing")
+	}
+	if err := validateObservedValsetsWindow(p.ObservedValsetsWindow); err != nil {
+		return sdkerrors.Wrap(err, "observed valsets window")
+	}
+	if err := validateMaxOrchestratorsPerValidator(p.MaxOrchestratorsPerValidator); err != nil {
+		return sdkerrors.Wrap(err, "max orchestrators per validator")
+	}
+	if err := validateBatchThresholds(p.BatchThresholds); err != nil {
+		return sdkerrors.Wrap(err, "batch thresholds")
+	}
+	if err := validateBatchSize(p.BatchSize); err != nil {
+		return sdkerrors.Wrap(err, "batch size")
+	}
+	if err := validateTokenBatchSizes(p.TokenBatchSizes); err != nil {
+		return sdkerrors.Wrap(err, "token batch sizes")
+	}
+	if err := validateAutoBatchTriggers(p.AutoBatchTriggers); err != nil {
+		return sdkerrors.Wrap(err, "auto batch triggers")
+	}
+	if err := validateEthKeyRotationTimeout(p.EthKeyRotationTimeout); err != nil {
+		return sdkerrors.Wrap(err, "eth key rotation timeout")
+	}
+	// a longer spacing could delay the valset without an unbonding validator past
+	// the window in which that validator is slashed for not signing it
+	if p.ValsetMinSpacing != 0 && p.ValsetMinSpacing >= p.UnbondSlashingValsetsWindow {
+		return sdkerrors.Wrapf(ErrInvalid, "valset min spacing %d not below unbond slashing valsets window %d", p.ValsetMinSpacing, p.UnbondSlashingValsetsWindow)
+	}
 
 	return nil
 }
@@ -192,6 +360,28 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingClaim, &p.SlashFractionConflictingClaim, validateSlashFractionConflictingClaim),
 		paramtypes.NewParamSetPair(ParamStoreSlashFractionBadEthSignature, &p.SlashFractionBadEthSignature, validateSlashFractionBadEthSignature),
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingValsetsWindow, &p.UnbondSlashingValsetsWindow, validateUnbondSlashingValsetsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignedLogicCallsWindow, &p.SignedLogicCallsWindow, validateSignedLogicCallsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreSlashFractionLogicCall, &p.SlashFractionLogicCall, validateSlashFractionLogicCall),
+		paramtypes.NewParamSetPair(ParamsStoreKeyAttestationVotesPowerThreshold, &p.AttestationVotesPowerThreshold, validateAttestationVotesPowerThreshold),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBridgeHalted, &p.BridgeHalted, validateBool),
+		paramtypes.NewParamSetPair(ParamsStoreKeyInboundDepositsPaused, &p.InboundDepositsPaused, validateBool),
+		paramtypes.NewParamSetPair(ParamsStoreKeyOutboundSendsPaused, &p.OutboundSendsPaused, validateBool),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBatchCreationPaused, &p.BatchCreationPaused, validateBool),
+		paramtypes.NewParamSetPair(ParamsStoreKeyOutboundRateLimitWindow, &p.OutboundRateLimitWindow, validateOutboundRateLimitWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyOutboundRateLimits, &p.OutboundRateLimits, validateOutboundRateLimits),
+		paramtypes.NewParamSetPair(ParamsStoreKeyOutboundGlobalWindowLimit, &p.OutboundGlobalWindowLimit, validateOutboundGlobalWindowLimit),
+		paramtypes.NewParamSetPair(ParamsStoreKeyInboundRateLimitWindow, &p.InboundRateLimitWindow, validateInboundRateLimitWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyInboundRateLimits, &p.InboundRateLimits, validateInboundRateLimits),
+		paramtypes.NewParamSetPair(ParamsStoreKeyValsetPowerChangeThreshold, &p.ValsetPowerChangeThreshold, validateValsetPowerChangeThreshold),
+		paramtypes.NewParamSetPair(ParamsStoreKeyValsetMaxAge, &p.ValsetMaxAge, validateValsetMaxAge),
+		paramtypes.NewParamSetPair(ParamsStoreKeyValsetMinSpacing, &p.ValsetMinSpacing, validateValsetMinSpacing),
+		paramtypes.NewParamSetPair(ParamsStoreKeyObservedValsetsWindow, &p.ObservedValsetsWindow, validateObservedValsetsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxOrchestratorsPerValidator, &p.MaxOrchestratorsPerValidator, validateMaxOrchestratorsPerValidator),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBatchThresholds, &p.BatchThresholds, validateBatchThresholds),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBatchSize, &p.BatchSize, validateBatchSize),
+		paramtypes.NewParamSetPair(ParamsStoreKeyTokenBatchSizes, &p.TokenBatchSizes, validateTokenBatchSizes),
+		paramtypes.NewParamSetPair(ParamsStoreKeyAutoBatchTriggers, &p.AutoBatchTriggers, validateAutoBatchTriggers),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEthKeyRotationTimeout, &p.EthKeyRotationTimeout, validateEthKeyRotationTimeout),
 	}
 }
 
@@ -345,6 +535,256 @@ func validateSlashFractionBadEthSignature(i interface{}) error {
 	return nil
 }
 
+func validateSignedLogicCallsWindow(i interface{}) error {
+	// TODO: do we want to set some bounds on this value?
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashFractionLogicCall(i interface{}) error {
+	// TODO: do we want to set some bounds on this value?
+	if _, ok := i.(sdk.Dec); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAttestationVotesPowerThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// anything up to half of the voting power would allow two conflicting
+	// attestations at the same event nonce to be observed
+	if v.IsNil() || v.LTE(sdk.NewDecWithPrec(5, 1)) || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid attestation votes power threshold, must be above 0.5 and at most 1: %s", v)
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOutboundRateLimitWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOutboundGlobalWindowLimit(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("invalid outbound global window limit: %s", v)
+	}
+	return nil
+}
+
+func validateOutboundRateLimits(i interface{}) error {
+	v, ok := i.([]OutboundRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, limit := range v {
+		contract := strings.ToLower(limit.TokenContract)
+		if contract != "" {
+			if err := ValidateEthAddress(limit.TokenContract); err != nil {
+				return sdkerrors.Wrap(err, "token contract")
+			}
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate outbound rate limit for %q", limit.TokenContract)
+		}
+		seen[contract] = true
+		if limit.WindowLimit.IsNil() || limit.WindowLimit.IsNegative() {
+			return fmt.Errorf("invalid window limit for %q: %s", limit.TokenContract, limit.WindowLimit)
+		}
+		if limit.MaxTransfer.IsNil() || limit.MaxTransfer.IsNegative() {
+			return fmt.Errorf("invalid max transfer for %q: %s", limit.TokenContract, limit.MaxTransfer)
+		}
+	}
+	return nil
+}
+
+func validateInboundRateLimitWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateInboundRateLimits(i interface{}) error {
+	v, ok := i.([]InboundRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, limit := range v {
+		contract := strings.ToLower(limit.TokenContract)
+		if contract != "" {
+			if err := ValidateEthAddress(limit.TokenContract); err != nil {
+				return sdkerrors.Wrap(err, "token contract")
+			}
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate inbound rate limit for %q", limit.TokenContract)
+		}
+		seen[contract] = true
+		if limit.WindowLimit.IsNil() || limit.WindowLimit.IsNegative() {
+			return fmt.Errorf("invalid window limit for %q: %s", limit.TokenContract, limit.WindowLimit)
+		}
+	}
+	return nil
+}
+
+func validateValsetPowerChangeThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid valset power change threshold, must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateValsetMaxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetMinSpacing(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateObservedValsetsWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the archive has to be pruned, otherwise it grows with every valset update
+	if v == 0 {
+		return fmt.Errorf("observed valsets window must be positive")
+	}
+	return nil
+}
+
+func validateMaxOrchestratorsPerValidator(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// every validator needs an orchestrator key
+	if v == 0 {
+		return fmt.Errorf("max orchestrators per validator must be positive")
+	}
+	return nil
+}
+
+func validateBatchThresholds(i interface{}) error {
+	v, ok := i.([]BatchThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, threshold := range v {
+		contract := strings.ToLower(threshold.TokenContract)
+		if contract != "" {
+			if err := ValidateEthAddress(threshold.TokenContract); err != nil {
+				return sdkerrors.Wrap(err, "token contract")
+			}
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate batch threshold for %q", threshold.TokenContract)
+		}
+		seen[contract] = true
+		if threshold.MinBatchFee.IsNil() || threshold.MinBatchFee.IsNegative() {
+			return fmt.Errorf("invalid min batch fee for %q: %s", threshold.TokenContract, threshold.MinBatchFee)
+		}
+	}
+	return nil
+}
+
+func validateBatchSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTokenBatchSizes(i interface{}) error {
+	v, ok := i.([]TokenBatchSize)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, size := range v {
+		if err := ValidateEthAddress(size.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		contract := strings.ToLower(size.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate batch size for %q", size.TokenContract)
+		}
+		seen[contract] = true
+		if size.BatchSize == 0 {
+			return fmt.Errorf("batch size for %q must be positive", size.TokenContract)
+		}
+	}
+	return nil
+}
+
+func validateAutoBatchTriggers(i interface{}) error {
+	v, ok := i.([]AutoBatchTrigger)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, trigger := range v {
+		contract := strings.ToLower(trigger.TokenContract)
+		if contract != "" {
+			if err := ValidateEthAddress(trigger.TokenContract); err != nil {
+				return sdkerrors.Wrap(err, "token contract")
+			}
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate auto batch trigger for %q", trigger.TokenContract)
+		}
+		seen[contract] = true
+		if trigger.MinFees.IsNil() || trigger.MinFees.IsNegative() {
+			return fmt.Errorf("invalid min fees for %q: %s", trigger.TokenContract, trigger.MinFees)
+		}
+	}
+	return nil
+}
+
+func validateEthKeyRotationTimeout(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// a rotation whose valset is never relayed would otherwise keep the previous key forever
+	if v == 0 {
+		return fmt.Errorf("eth key rotation timeout must be positive")
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
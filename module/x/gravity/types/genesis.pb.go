@@ -56,9 +56,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // signed_valsets_window
 // signed_batches_window
 // signed_claims_window
+// signed_logic_calls_window
 //
 // These values represent the time in blocks that a validator has to submit
-// a signature for a batch or valset, or to submit a claim for a particular
+// a signature for a batch, valset or logic call, or to submit a claim for a particular
 // attestation nonce. In the case of attestations this clock starts when the
 // attestation is created, but only allows for slashing once the event has passed
 //
@@ -80,27 +81,155 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // slash_fraction_batch
 // slash_fraction_claim
 // slash_fraction_conflicting_claim
+// slash_fraction_logic_call
 //
 // The slashing fractions for the various gravity related slashing conditions. The first three
-// refer to not submitting a particular message, the third for submitting a different claim
-// for the same Ethereum event
+// refer to not submitting a particular message, the fourth for submitting a different claim
+// for the same Ethereum event and the last for not signing an outgoing logic call
+//
+// attestation_votes_power_threshold
+//
+// The fraction of the total voting power that has to vote for an attestation before
+// it is observed and applied to the Cosmos state, must be above 0.5 and at most 1
+//
+// bridge_halted
+//
+// Set by the Gravity module when it detects that the bridge has been hijacked, no
+// outgoing batches or logic calls are created while it is set. Only governance
+// can clear it once the incident has been dealt with
+//
+// inbound_deposits_paused
+//
+// Set by governance to pause deposits from Ethereum, deposits observed while paused
+// are queued and credited once deposits are resumed
+//
+// outbound_sends_paused
+//
+// Set by governance to pause sending tokens to Ethereum, no new transfers are added
+// to the outgoing pool while it is set. Pending transfers can still be cancelled
+//
+// batch_creation_paused
+//
+// # Set by governance to pause the creation of new outgoing batches
+//
+// outbound_rate_limit_window
+//
+// The number of Cosmos blocks over which the amount sent to Ethereum is limited
+// by outbound_rate_limits
+//
+// outbound_rate_limits
+//
+// Per token limits on the amount sent to Ethereum within the outbound rate limit
+// window and on the size of a single transfer
+//
+// outbound_global_window_limit
+//
+// The maximum total amount of all tokens together sent to Ethereum within the
+// outbound rate limit window, 0 disables it. Unlike the outbound_rate_limits
+// entry without a token contract, which limits every token on its own, it caps
+// the sum over all tokens
+//
+// inbound_rate_limit_window
+//
+// The number of Cosmos blocks over which the amount deposited from Ethereum is
+// limited by inbound_rate_limits
+//
+// inbound_rate_limits
+//
+// Per token limits on the amount deposited from Ethereum that is credited within
+// the inbound rate limit window, deposits above the limit are queued and credited
+// once the window allows it
+//
+// valset_power_change_threshold
+//
+// The fraction of the normalized bridge power that has to change compared to the
+// latest valset before a new valset is created, must be between 0 and 1
+//
+// valset_max_age
+//
+// The number of Cosmos blocks after which a new valset is created even if the
+// validator set did not change, 0 disables this trigger
+//
+// valset_min_spacing
+//
+// The minimum number of Cosmos blocks between two valsets, any trigger that fires
+// earlier waits until the spacing has passed. Must be below the unbond slashing
+// valsets window so that unbonding validators can still sign a valset without them
+//
+// observed_valsets_window
+//
+// The number of Cosmos blocks for which an observed valset is kept in the
+// archive after it was replaced on Ethereum, must be positive
+//
+// max_orchestrators_per_validator
+//
+// The maximum number of orchestrator keys a validator can delegate to, any of
+// them can submit claims and confirms on behalf of the validator. Lowering it
+// leaves the keys in place but no more can be added until a validator is below it
+//
+// batch_thresholds
+//
+// Per token minimum total fee and number of transactions of a batch, a batch
+// below them is not created since relayers would not pick it up
+//
+// batch_size
+//
+// The maximum number of transactions in a batch of a token without a batch
+// size of its own, 0 uses the default of 100
+//
+// token_batch_sizes
+//
+// Per token maximum number of transactions in a batch, for tokens that are
+// more expensive to transfer on Ethereum
+//
+// auto_batch_triggers
+//
+// Per token conditions under which the EndBlocker builds a batch without a
+// MsgRequestBatch, no batches are built automatically if empty
+//
+// eth_key_rotation_timeout
+//
+// The number of Cosmos blocks after which an Ethereum key rotation is completed
+// even if no valset including the new key was observed, must be positive
 type Params struct {
-	GravityId                     string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash            string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress         string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId                 uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedValsetsWindow           uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
-	SignedBatchesWindow           uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	SignedClaimsWindow            uint64                                 `protobuf:"varint,8,opt,name=signed_claims_window,json=signedClaimsWindow,proto3" json:"signed_claims_window,omitempty"`
-	TargetBatchTimeout            uint64                                 `protobuf:"varint,10,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
-	AverageBlockTime              uint64                                 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime      uint64                                 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	SlashFractionValset           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
-	SlashFractionBatch            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionClaim            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
-	SlashFractionConflictingClaim github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
-	UnbondSlashingValsetsWindow   uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
-	SlashFractionBadEthSignature  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
+	GravityId                      string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	ContractSourceHash             string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress          string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                  uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedValsetsWindow            uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
+	SignedBatchesWindow            uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	SignedClaimsWindow             uint64                                 `protobuf:"varint,8,opt,name=signed_claims_window,json=signedClaimsWindow,proto3" json:"signed_claims_window,omitempty"`
+	TargetBatchTimeout             uint64                                 `protobuf:"varint,10,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
+	AverageBlockTime               uint64                                 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime       uint64                                 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	SlashFractionValset            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
+	SlashFractionBatch             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionClaim             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
+	SlashFractionConflictingClaim  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
+	UnbondSlashingValsetsWindow    uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
+	SlashFractionBadEthSignature   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
+	SignedLogicCallsWindow         uint64                                 `protobuf:"varint,19,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
+	SlashFractionLogicCall         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
+	AttestationVotesPowerThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=attestation_votes_power_threshold,json=attestationVotesPowerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"attestation_votes_power_threshold"`
+	BridgeHalted                   bool                                   `protobuf:"varint,22,opt,name=bridge_halted,json=bridgeHalted,proto3" json:"bridge_halted,omitempty"`
+	InboundDepositsPaused          bool                                   `protobuf:"varint,23,opt,name=inbound_deposits_paused,json=inboundDepositsPaused,proto3" json:"inbound_deposits_paused,omitempty"`
+	OutboundSendsPaused            bool                                   `protobuf:"varint,24,opt,name=outbound_sends_paused,json=outboundSendsPaused,proto3" json:"outbound_sends_paused,omitempty"`
+	BatchCreationPaused            bool                                   `protobuf:"varint,25,opt,name=batch_creation_paused,json=batchCreationPaused,proto3" json:"batch_creation_paused,omitempty"`
+	OutboundRateLimitWindow        uint64                                 `protobuf:"varint,26,opt,name=outbound_rate_limit_window,json=outboundRateLimitWindow,proto3" json:"outbound_rate_limit_window,omitempty"`
+	OutboundRateLimits             []OutboundRateLimit                    `protobuf:"bytes,27,rep,name=outbound_rate_limits,json=outboundRateLimits,proto3" json:"outbound_rate_limits"`
+	InboundRateLimitWindow         uint64                                 `protobuf:"varint,28,opt,name=inbound_rate_limit_window,json=inboundRateLimitWindow,proto3" json:"inbound_rate_limit_window,omitempty"`
+	InboundRateLimits              []InboundRateLimit                     `protobuf:"bytes,29,rep,name=inbound_rate_limits,json=inboundRateLimits,proto3" json:"inbound_rate_limits"`
+	ValsetPowerChangeThreshold     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,30,opt,name=valset_power_change_threshold,json=valsetPowerChangeThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_power_change_threshold"`
+	ValsetMaxAge                   uint64                                 `protobuf:"varint,31,opt,name=valset_max_age,json=valsetMaxAge,proto3" json:"valset_max_age,omitempty"`
+	ValsetMinSpacing               uint64                                 `protobuf:"varint,32,opt,name=valset_min_spacing,json=valsetMinSpacing,proto3" json:"valset_min_spacing,omitempty"`
+	ObservedValsetsWindow          uint64                                 `protobuf:"varint,33,opt,name=observed_valsets_window,json=observedValsetsWindow,proto3" json:"observed_valsets_window,omitempty"`
+	MaxOrchestratorsPerValidator   uint64                                 `protobuf:"varint,34,opt,name=max_orchestrators_per_validator,json=maxOrchestratorsPerValidator,proto3" json:"max_orchestrators_per_validator,omitempty"`
+	BatchThresholds                []BatchThreshold                       `protobuf:"bytes,35,rep,name=batch_thresholds,json=batchThresholds,proto3" json:"batch_thresholds"`
+	BatchSize                      uint64                                 `protobuf:"varint,36,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	TokenBatchSizes                []TokenBatchSize                       `protobuf:"bytes,37,rep,name=token_batch_sizes,json=tokenBatchSizes,proto3" json:"token_batch_sizes"`
+	AutoBatchTriggers              []AutoBatchTrigger                     `protobuf:"bytes,38,rep,name=auto_batch_triggers,json=autoBatchTriggers,proto3" json:"auto_batch_triggers"`
+	EthKeyRotationTimeout          uint64                                 `protobuf:"varint,39,opt,name=eth_key_rotation_timeout,json=ethKeyRotationTimeout,proto3" json:"eth_key_rotation_timeout,omitempty"`
+	OutboundGlobalWindowLimit      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,40,opt,name=outbound_global_window_limit,json=outboundGlobalWindowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outbound_global_window_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -213,20 +342,152 @@ func (m *Params) GetUnbondSlashingValsetsWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetSignedLogicCallsWindow() uint64 {
+	if m != nil {
+		return m.SignedLogicCallsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeHalted() bool {
+	if m != nil {
+		return m.BridgeHalted
+	}
+	return false
+}
+
+func (m *Params) GetInboundDepositsPaused() bool {
+	if m != nil {
+		return m.InboundDepositsPaused
+	}
+	return false
+}
+
+func (m *Params) GetOutboundSendsPaused() bool {
+	if m != nil {
+		return m.OutboundSendsPaused
+	}
+	return false
+}
+
+func (m *Params) GetBatchCreationPaused() bool {
+	if m != nil {
+		return m.BatchCreationPaused
+	}
+	return false
+}
+
+func (m *Params) GetOutboundRateLimitWindow() uint64 {
+	if m != nil {
+		return m.OutboundRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetOutboundRateLimits() []OutboundRateLimit {
+	if m != nil {
+		return m.OutboundRateLimits
+	}
+	return nil
+}
+
+func (m *Params) GetInboundRateLimitWindow() uint64 {
+	if m != nil {
+		return m.InboundRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetInboundRateLimits() []InboundRateLimit {
+	if m != nil {
+		return m.InboundRateLimits
+	}
+	return nil
+}
+
+func (m *Params) GetValsetMaxAge() uint64 {
+	if m != nil {
+		return m.ValsetMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetValsetMinSpacing() uint64 {
+	if m != nil {
+		return m.ValsetMinSpacing
+	}
+	return 0
+}
+
+func (m *Params) GetObservedValsetsWindow() uint64 {
+	if m != nil {
+		return m.ObservedValsetsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrchestratorsPerValidator() uint64 {
+	if m != nil {
+		return m.MaxOrchestratorsPerValidator
+	}
+	return 0
+}
+
+func (m *Params) GetBatchThresholds() []BatchThreshold {
+	if m != nil {
+		return m.BatchThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetBatchSize() uint64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetTokenBatchSizes() []TokenBatchSize {
+	if m != nil {
+		return m.TokenBatchSizes
+	}
+	return nil
+}
+
+func (m *Params) GetAutoBatchTriggers() []AutoBatchTrigger {
+	if m != nil {
+		return m.AutoBatchTriggers
+	}
+	return nil
+}
+
+func (m *Params) GetEthKeyRotationTimeout() uint64 {
+	if m != nil {
+		return m.EthKeyRotationTimeout
+	}
+	return 0
+}
+
 // GenesisState struct
 type GenesisState struct {
-	Params             *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedNonce  uint64                       `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	Valsets            []*Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
-	ValsetConfirms     []*MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
-	Batches            []*OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
-	BatchConfirms      []MsgConfirmBatch            `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls         []*OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
-	LogicCallConfirms  []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations       []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys       []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms      []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedTransfers []*OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	Params                *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedNonce     uint64                       `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	Valsets               []*Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	ValsetConfirms        []*MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
+	Batches               []*OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
+	BatchConfirms         []MsgConfirmBatch            `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls            []*OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
+	LogicCallConfirms     []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations          []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys          []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms         []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedTransfers    []*OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	BridgeHijackIncidents []BridgeHijackIncident       `protobuf:"bytes,13,rep,name=bridge_hijack_incidents,json=bridgeHijackIncidents,proto3" json:"bridge_hijack_incidents"`
+	QueuedDeposits        []QueuedDeposit              `protobuf:"bytes,14,rep,name=queued_deposits,json=queuedDeposits,proto3" json:"queued_deposits"`
+	ObservedValsets       []ObservedValset             `protobuf:"bytes,15,rep,name=observed_valsets,json=observedValsets,proto3" json:"observed_valsets"`
+	EthKeyRotations       []EthKeyRotation             `protobuf:"bytes,16,rep,name=eth_key_rotations,json=ethKeyRotations,proto3" json:"eth_key_rotations"`
+	// attestations up to this event nonce were slashed and pruned
+	LastSlashedAttestationNonce uint64 `protobuf:"varint,17,opt,name=last_slashed_attestation_nonce,json=lastSlashedAttestationNonce,proto3" json:"last_slashed_attestation_nonce,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -346,6 +607,41 @@ func (m *GenesisState) GetUnbatchedTransfers() []*OutgoingTransferTx {
 	return nil
 }
 
+func (m *GenesisState) GetBridgeHijackIncidents() []BridgeHijackIncident {
+	if m != nil {
+		return m.BridgeHijackIncidents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedDeposits() []QueuedDeposit {
+	if m != nil {
+		return m.QueuedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservedValsets() []ObservedValset {
+	if m != nil {
+		return m.ObservedValsets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthKeyRotations() []EthKeyRotation {
+	if m != nil {
+		return m.EthKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastSlashedAttestationNonce() uint64 {
+	if m != nil {
+		return m.LastSlashedAttestationNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
@@ -354,66 +650,106 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0x8f, 0xa9, 0xeb, 0x24, 0x13, 0x3b, 0x69, 0xc6, 0x09, 0x1d, 0xe5, 0x8f, 0x63, 0x55, 0xa2,
-	0x8a, 0x50, 0x6b, 0xa7, 0x41, 0x80, 0x84, 0x04, 0x22, 0x76, 0x03, 0x2d, 0x50, 0x02, 0xeb, 0x00,
-	0x12, 0x97, 0x61, 0x76, 0x77, 0xb2, 0xbb, 0xca, 0xee, 0x4e, 0x34, 0x33, 0xeb, 0x24, 0x37, 0x3e,
-	0x02, 0x57, 0xbe, 0x51, 0x8f, 0x3d, 0x21, 0x84, 0x50, 0x85, 0x92, 0x2f, 0x82, 0xf6, 0xcd, 0xec,
-	0x7a, 0xe3, 0xf8, 0x14, 0xf5, 0xe4, 0xf1, 0xfb, 0xfd, 0x79, 0x4f, 0xef, 0xcd, 0xbe, 0x41, 0x24,
-	0x90, 0x6c, 0x1c, 0xe9, 0xcb, 0xfe, 0xf8, 0x59, 0x3f, 0xe0, 0x29, 0x57, 0x91, 0xea, 0x9d, 0x49,
-	0xa1, 0x05, 0x46, 0x16, 0xe9, 0x8d, 0x9f, 0x6d, 0xac, 0x05, 0x22, 0x10, 0x10, 0xee, 0xe7, 0x27,
-	0xc3, 0xd8, 0x78, 0xbf, 0xa2, 0xd5, 0x97, 0x67, 0xdc, 0x2a, 0x37, 0xd6, 0x2b, 0xf1, 0x44, 0x05,
-	0x6a, 0x06, 0xdd, 0x65, 0xda, 0x0b, 0x6d, 0x7c, 0xab, 0x12, 0x67, 0x5a, 0x73, 0xa5, 0x99, 0x8e,
-	0x44, 0x6a, 0xd0, 0x47, 0x7f, 0x2d, 0xa0, 0xc6, 0x0f, 0x4c, 0xb2, 0x44, 0xe1, 0x6d, 0x54, 0xd4,
-	0x44, 0x23, 0x9f, 0xd4, 0xba, 0xb5, 0xdd, 0x45, 0x67, 0xd1, 0x46, 0x5e, 0xfa, 0x78, 0x0f, 0xad,
-	0x79, 0x22, 0xd5, 0x92, 0x79, 0x9a, 0x2a, 0x91, 0x49, 0x8f, 0xd3, 0x90, 0xa9, 0x90, 0xbc, 0x07,
-	0x44, 0x5c, 0x60, 0x23, 0x80, 0x5e, 0x30, 0x15, 0xe2, 0x4f, 0xd0, 0x43, 0x57, 0x46, 0x7e, 0xc0,
-	0x29, 0xd7, 0x21, 0x97, 0x3c, 0x4b, 0x28, 0xf3, 0x7d, 0xc9, 0x95, 0x22, 0x75, 0x10, 0xad, 0x1b,
-	0xf8, 0xd0, 0xa2, 0x07, 0x06, 0xc4, 0x8f, 0xd1, 0x8a, 0xd5, 0x79, 0x21, 0x8b, 0xd2, 0xbc, 0x9a,
-	0xfb, 0xdd, 0xda, 0x6e, 0xdd, 0x69, 0x99, 0xf0, 0x30, 0x8f, 0xbe, 0xf4, 0xf1, 0x3e, 0x5a, 0x57,
-	0x51, 0x90, 0x72, 0x9f, 0x8e, 0x59, 0xac, 0xb8, 0x56, 0xf4, 0x3c, 0x4a, 0x7d, 0x71, 0x4e, 0x1a,
-	0xc0, 0x6e, 0x1b, 0xf0, 0x67, 0x83, 0xfd, 0x02, 0x50, 0x45, 0x03, 0x3d, 0xe2, 0xa5, 0x66, 0xbe,
-	0xaa, 0x19, 0x18, 0xcc, 0x6a, 0xf6, 0xd0, 0x9a, 0xd5, 0x78, 0x31, 0x8b, 0x92, 0x52, 0xb2, 0x00,
-	0x12, 0x6c, 0xb0, 0x21, 0x40, 0x13, 0x85, 0x66, 0x32, 0xe0, 0xda, 0x64, 0xa1, 0x3a, 0x4a, 0xb8,
-	0xc8, 0x34, 0x41, 0x46, 0x61, 0x30, 0x48, 0x72, 0x6c, 0x10, 0xfc, 0x04, 0x61, 0x36, 0xe6, 0x92,
-	0x05, 0x9c, 0xba, 0xb1, 0xf0, 0x4e, 0x41, 0x42, 0x96, 0x80, 0xff, 0xc0, 0x22, 0x83, 0x1c, 0xc8,
-	0x05, 0xf8, 0x73, 0xb4, 0x59, 0xb0, 0xcb, 0xd6, 0x56, 0x64, 0x4d, 0x90, 0x11, 0x4b, 0x29, 0xda,
-	0x3b, 0x91, 0xbb, 0x68, 0x5d, 0xc5, 0x4c, 0x85, 0xf4, 0x24, 0x9f, 0x58, 0x24, 0x52, 0xdb, 0x40,
-	0xd2, 0xea, 0xd6, 0x76, 0x9b, 0x83, 0xde, 0xeb, 0xb7, 0x3b, 0x73, 0xff, 0xbc, 0xdd, 0x79, 0x1c,
-	0x44, 0x3a, 0xcc, 0xdc, 0x9e, 0x27, 0x92, 0xbe, 0x27, 0x54, 0x22, 0x94, 0xfd, 0x79, 0xaa, 0xfc,
-	0x53, 0x7b, 0x25, 0x9f, 0x73, 0xcf, 0x69, 0x83, 0xd9, 0x57, 0xd6, 0xcb, 0xf4, 0x1b, 0xff, 0x86,
-	0xd6, 0xa6, 0x72, 0x40, 0x2b, 0xc8, 0xf2, 0x9d, 0x52, 0xe0, 0x1b, 0x29, 0xa0, 0x73, 0x33, 0x32,
-	0xc0, 0x78, 0xc8, 0xca, 0x3b, 0xc8, 0x00, 0xd3, 0xc4, 0xe7, 0xa8, 0x3b, 0x9d, 0x41, 0xa4, 0x27,
-	0x71, 0xe4, 0xe9, 0x28, 0x0d, 0x6c, 0xb6, 0x07, 0x77, 0xca, 0xb6, 0x7d, 0x33, 0xdb, 0xc4, 0xd5,
-	0x24, 0x1e, 0xa2, 0x4e, 0x96, 0xba, 0x22, 0xf5, 0x29, 0xf0, 0xf2, 0x6c, 0x53, 0x57, 0x7c, 0x15,
-	0x46, 0xbc, 0x69, 0x58, 0x23, 0x4b, 0xba, 0x79, 0xd5, 0xc7, 0xb7, 0xaa, 0x77, 0x99, 0x9f, 0xdf,
-	0x17, 0x9a, 0xdf, 0x58, 0xa6, 0x33, 0xc9, 0x09, 0xbe, 0x53, 0xf5, 0x5b, 0x53, 0xd3, 0xf0, 0x0f,
-	0x75, 0x38, 0x2a, 0x3c, 0x3f, 0xab, 0xff, 0xfe, 0x6f, 0x77, 0xee, 0xd1, 0x9f, 0x0d, 0xd4, 0xfc,
-	0xda, 0x6c, 0xbc, 0x91, 0x66, 0x9a, 0xe3, 0x0f, 0x51, 0xe3, 0x0c, 0x16, 0x0d, 0xac, 0x96, 0xa5,
-	0x7d, 0xdc, 0x9b, 0x6c, 0xc0, 0x9e, 0x59, 0x41, 0x8e, 0x65, 0xe0, 0x1e, 0x6a, 0xc7, 0x4c, 0x69,
-	0x2a, 0x5c, 0xc5, 0xe5, 0x98, 0xfb, 0x34, 0x15, 0xa9, 0xc7, 0x61, 0xd5, 0xd4, 0x9d, 0xd5, 0x1c,
-	0x3a, 0xb2, 0xc8, 0xf7, 0x39, 0x80, 0x9f, 0xa0, 0x79, 0xdb, 0x1f, 0x72, 0xaf, 0x7b, 0x6f, 0xda,
-	0xdc, 0xb4, 0xc5, 0x29, 0x28, 0xf8, 0x10, 0xad, 0x98, 0x23, 0x8c, 0x33, 0x92, 0x49, 0xbe, 0x8f,
-	0x72, 0xd5, 0x56, 0x55, 0xf5, 0x4a, 0xd9, 0x7e, 0x0e, 0x0d, 0xc9, 0x59, 0x1e, 0x57, 0xff, 0x2a,
-	0xfc, 0x31, 0x9a, 0xb7, 0x3b, 0x84, 0xdc, 0x07, 0xf9, 0x66, 0x55, 0x7e, 0x94, 0xe9, 0x40, 0x44,
-	0x69, 0x70, 0x7c, 0x01, 0xb7, 0xd5, 0x29, 0xb8, 0xf8, 0x05, 0x5a, 0x36, 0x4b, 0xa1, 0x4c, 0xde,
-	0xb8, 0xad, 0x7e, 0xa5, 0x02, 0x9b, 0x07, 0xd4, 0x83, 0x7a, 0x3e, 0x21, 0xa7, 0x05, 0xc2, 0xb2,
-	0x80, 0x2f, 0xd0, 0x52, 0x2c, 0x82, 0xc8, 0xa3, 0x1e, 0x8b, 0x63, 0x45, 0xe6, 0xc1, 0x66, 0x7b,
-	0x56, 0x11, 0xdf, 0xe5, 0xb4, 0x21, 0x8b, 0x63, 0x07, 0xc5, 0xc5, 0x51, 0xe1, 0x9f, 0x50, 0x7b,
-	0xa2, 0x9f, 0x94, 0xb3, 0x00, 0x3e, 0x3b, 0xb3, 0xcb, 0x29, 0x9d, 0x6c, 0x49, 0xab, 0xa5, 0x5f,
-	0x59, 0xd6, 0x01, 0x6a, 0x56, 0xde, 0x19, 0x45, 0x16, 0xc1, 0xef, 0x61, 0xd5, 0xef, 0x60, 0x82,
-	0x5b, 0x9f, 0x1b, 0x12, 0xfc, 0x0d, 0x6a, 0xf9, 0x3c, 0xe6, 0x01, 0xd3, 0x9c, 0x9e, 0xf2, 0x4b,
-	0x45, 0x10, 0x78, 0x7c, 0x30, 0x55, 0xd3, 0x88, 0xeb, 0x23, 0x99, 0x37, 0x55, 0x4b, 0xa6, 0x85,
-	0xb4, 0xef, 0x87, 0xd3, 0x2c, 0xb4, 0xdf, 0xf2, 0x4b, 0x85, 0xbf, 0x44, 0x2b, 0x5c, 0x7a, 0xfb,
-	0x7b, 0x54, 0x0b, 0xea, 0xf3, 0x54, 0x24, 0x8a, 0x2c, 0x81, 0x1b, 0xa9, 0xba, 0x1d, 0x3a, 0xc3,
-	0xfd, 0xbd, 0x63, 0xf1, 0x3c, 0x27, 0x38, 0x2d, 0x10, 0xd8, 0x7f, 0x0a, 0x1f, 0xa1, 0x76, 0x96,
-	0x9a, 0xf1, 0xf9, 0x54, 0x4b, 0x96, 0xaa, 0x13, 0x2e, 0x15, 0x69, 0x82, 0x4b, 0x67, 0xe6, 0xd0,
-	0x2d, 0xe9, 0xf8, 0xc2, 0xc1, 0xa5, 0xb4, 0x08, 0xaa, 0xc1, 0x8f, 0xaf, 0xaf, 0x3a, 0xb5, 0x37,
-	0x57, 0x9d, 0xda, 0x7f, 0x57, 0x9d, 0xda, 0x1f, 0xd7, 0x9d, 0xb9, 0x37, 0xd7, 0x9d, 0xb9, 0xbf,
-	0xaf, 0x3b, 0x73, 0xbf, 0x7e, 0x7a, 0xfb, 0x0b, 0xb4, 0xf6, 0x4f, 0xcd, 0xdb, 0xd7, 0x4f, 0x84,
-	0x9f, 0xc5, 0xbc, 0x7f, 0x51, 0xc4, 0xcd, 0x67, 0xe9, 0x36, 0xe0, 0x39, 0xff, 0xe8, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf5, 0xb8, 0x81, 0x9a, 0x71, 0x08, 0x00, 0x00,
+	// 1580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x52, 0x1b, 0x47,
+	0x16, 0x46, 0x6b, 0x0c, 0xa6, 0x11, 0x7f, 0x2d, 0x04, 0xcd, 0x9f, 0x90, 0x7f, 0x97, 0xda, 0xb2,
+	0x01, 0xb3, 0xb5, 0xeb, 0xda, 0xdd, 0xda, 0xad, 0x05, 0x99, 0xb5, 0x59, 0xdb, 0x01, 0x8f, 0xb0,
+	0x53, 0x95, 0x8b, 0x74, 0x5a, 0x33, 0xed, 0x51, 0x87, 0x51, 0xb7, 0x3c, 0xdd, 0x12, 0xe0, 0xab,
+	0x3c, 0x42, 0xde, 0x21, 0x2f, 0xe3, 0x4b, 0x5f, 0xa6, 0x52, 0x89, 0x2b, 0x65, 0xbf, 0x48, 0xaa,
+	0xff, 0xa4, 0xd1, 0x48, 0x37, 0xa1, 0x72, 0x25, 0xe9, 0x7c, 0xe7, 0xfb, 0xce, 0x51, 0x9f, 0x33,
+	0xa7, 0xcf, 0x00, 0x14, 0xa7, 0xa4, 0xcb, 0xd4, 0xe5, 0x4e, 0xf7, 0xe1, 0x4e, 0x4c, 0x39, 0x95,
+	0x4c, 0x6e, 0xb7, 0x53, 0xa1, 0x04, 0x04, 0x0e, 0xd9, 0xee, 0x3e, 0x5c, 0x5d, 0x8c, 0x45, 0x2c,
+	0x8c, 0x79, 0x47, 0x7f, 0xb3, 0x1e, 0xab, 0x4b, 0x19, 0xae, 0xba, 0x6c, 0x53, 0xc7, 0x5c, 0x2d,
+	0x67, 0xec, 0x2d, 0x19, 0xcb, 0x11, 0xee, 0x0d, 0xa2, 0xc2, 0xa6, 0xb3, 0xaf, 0x67, 0xec, 0x44,
+	0x29, 0x2a, 0x15, 0x51, 0x4c, 0xf0, 0x11, 0x62, 0x6d, 0x21, 0x12, 0x6b, 0xbe, 0xf5, 0xcb, 0x22,
+	0x98, 0x38, 0x21, 0x29, 0x69, 0x49, 0xb8, 0x01, 0x7c, 0xaa, 0x98, 0x45, 0xa8, 0x50, 0x2d, 0x6c,
+	0x4d, 0x05, 0x53, 0xce, 0x72, 0x14, 0xc1, 0x5d, 0xb0, 0x18, 0x0a, 0xae, 0x52, 0x12, 0x2a, 0x2c,
+	0x45, 0x27, 0x0d, 0x29, 0x6e, 0x12, 0xd9, 0x44, 0x7f, 0x32, 0x8e, 0xd0, 0x63, 0x75, 0x03, 0x3d,
+	0x25, 0xb2, 0x09, 0xff, 0x0e, 0x96, 0x1b, 0x29, 0x8b, 0x62, 0x8a, 0xa9, 0x6a, 0xd2, 0x94, 0x76,
+	0x5a, 0x98, 0x44, 0x51, 0x4a, 0xa5, 0x44, 0xe3, 0x86, 0x54, 0xb6, 0xf0, 0xa1, 0x43, 0xf7, 0x2d,
+	0x08, 0xef, 0x81, 0x39, 0xc7, 0x0b, 0x9b, 0x84, 0x71, 0x9d, 0xcd, 0xf5, 0x6a, 0x61, 0x6b, 0x3c,
+	0x98, 0xb1, 0xe6, 0x9a, 0xb6, 0x1e, 0x45, 0x70, 0x0f, 0x94, 0x25, 0x8b, 0x39, 0x8d, 0x70, 0x97,
+	0x24, 0x92, 0x2a, 0x89, 0xcf, 0x19, 0x8f, 0xc4, 0x39, 0x9a, 0x30, 0xde, 0x25, 0x0b, 0xbe, 0xb6,
+	0xd8, 0x97, 0x06, 0xca, 0x70, 0xcc, 0xd1, 0xd1, 0x1e, 0x67, 0x32, 0xcb, 0x39, 0xb0, 0x98, 0xe3,
+	0xec, 0x82, 0x45, 0xc7, 0x09, 0x13, 0xc2, 0x5a, 0x3d, 0xca, 0x0d, 0x43, 0x81, 0x16, 0xab, 0x19,
+	0xa8, 0xcf, 0x50, 0x24, 0x8d, 0xa9, 0xb2, 0x51, 0xb0, 0x62, 0x2d, 0x2a, 0x3a, 0x0a, 0x01, 0xcb,
+	0xb0, 0x98, 0x09, 0x72, 0x6a, 0x11, 0x78, 0x1f, 0x40, 0xd2, 0xa5, 0x29, 0x89, 0x29, 0x6e, 0x24,
+	0x22, 0x3c, 0x33, 0x14, 0x34, 0x6d, 0xfc, 0xe7, 0x1d, 0x72, 0xa0, 0x01, 0x4d, 0x80, 0xff, 0x06,
+	0x6b, 0xde, 0xbb, 0x77, 0xb4, 0x19, 0x5a, 0xd1, 0xd0, 0x90, 0x73, 0xf1, 0xc7, 0xdb, 0xa7, 0x37,
+	0x40, 0x59, 0x26, 0x44, 0x36, 0xf1, 0x1b, 0x5d, 0x31, 0x26, 0xb8, 0x3b, 0x40, 0x34, 0x53, 0x2d,
+	0x6c, 0x15, 0x0f, 0xb6, 0xdf, 0x7f, 0xdc, 0x1c, 0xfb, 0xe9, 0xe3, 0xe6, 0xbd, 0x98, 0xa9, 0x66,
+	0xa7, 0xb1, 0x1d, 0x8a, 0xd6, 0x4e, 0x28, 0x64, 0x4b, 0x48, 0xf7, 0xf1, 0x40, 0x46, 0x67, 0xae,
+	0x53, 0x1f, 0xd3, 0x30, 0x28, 0x19, 0xb1, 0xff, 0x39, 0x2d, 0x7b, 0xde, 0xf0, 0x1b, 0xb0, 0x98,
+	0x8b, 0x61, 0x8e, 0x02, 0xcd, 0x5e, 0x29, 0x04, 0x1c, 0x08, 0x61, 0x4e, 0x6e, 0x44, 0x04, 0x53,
+	0x1e, 0x34, 0xf7, 0x07, 0x44, 0x30, 0xd5, 0x84, 0xe7, 0xa0, 0x9a, 0x8f, 0x20, 0xf8, 0x9b, 0x84,
+	0x85, 0x8a, 0xf1, 0xd8, 0x45, 0x9b, 0xbf, 0x52, 0xb4, 0x8d, 0xc1, 0x68, 0x7d, 0x55, 0x1b, 0xb8,
+	0x06, 0x2a, 0x1d, 0xde, 0x10, 0x3c, 0xc2, 0xc6, 0x4f, 0x47, 0xcb, 0xb5, 0xf8, 0x82, 0x29, 0xf1,
+	0x9a, 0xf5, 0xaa, 0x3b, 0xa7, 0xc1, 0x56, 0xef, 0x0e, 0x65, 0xdf, 0x20, 0x91, 0xee, 0x17, 0xac,
+	0x3b, 0x96, 0xa8, 0x4e, 0x4a, 0x11, 0xbc, 0x52, 0xf6, 0xeb, 0xb9, 0x6a, 0x44, 0x87, 0xaa, 0x59,
+	0xf7, 0x9a, 0xf0, 0x1f, 0x60, 0xc5, 0x3d, 0x2e, 0x89, 0x88, 0x59, 0x88, 0x43, 0x92, 0x24, 0xbd,
+	0xbc, 0x4b, 0x26, 0xef, 0x25, 0xeb, 0xf0, 0x5c, 0xe3, 0x35, 0x0d, 0xbb, 0x94, 0x19, 0x58, 0xc9,
+	0xa5, 0xdc, 0x97, 0x40, 0x8b, 0x57, 0xca, 0x75, 0x69, 0x20, 0xd7, 0x5e, 0x44, 0x78, 0x09, 0x6e,
+	0x66, 0x86, 0x24, 0xee, 0x0a, 0x45, 0x25, 0x6e, 0x8b, 0x73, 0x9a, 0x62, 0xd5, 0x4c, 0xa9, 0x6c,
+	0x8a, 0x24, 0x42, 0xe5, 0x2b, 0x85, 0xac, 0x64, 0x84, 0x5f, 0x6b, 0xdd, 0x13, 0x2d, 0x7b, 0xea,
+	0x55, 0xe1, 0x6d, 0xe0, 0x06, 0x19, 0x6e, 0x92, 0x44, 0xd1, 0x08, 0x2d, 0x55, 0x0b, 0x5b, 0x37,
+	0x82, 0xa2, 0x35, 0x3e, 0x35, 0x36, 0x3d, 0x3c, 0x19, 0x6f, 0x88, 0x0e, 0x8f, 0x70, 0x44, 0xdb,
+	0x42, 0x32, 0x25, 0x71, 0x9b, 0x74, 0x24, 0x8d, 0xd0, 0xb2, 0x71, 0x2f, 0x3b, 0xf8, 0xb1, 0x43,
+	0x4f, 0x0c, 0xa8, 0x07, 0x9c, 0xe8, 0x28, 0x4b, 0x94, 0x94, 0x47, 0x3d, 0x16, 0x32, 0xac, 0x92,
+	0x07, 0xeb, 0x1a, 0xeb, 0x73, 0xec, 0x9c, 0x0a, 0x53, 0x6a, 0x8f, 0xc3, 0x71, 0x56, 0x2c, 0xc7,
+	0x80, 0x35, 0x87, 0x39, 0xce, 0xbf, 0xc0, 0x6a, 0x2f, 0x4e, 0x4a, 0x14, 0xc5, 0x09, 0x6b, 0x31,
+	0xe5, 0xcb, 0xbc, 0x6a, 0xca, 0xbc, 0xec, 0x3d, 0x02, 0xa2, 0xe8, 0x73, 0x8d, 0xbb, 0x3a, 0xbf,
+	0x02, 0x8b, 0x23, 0xc8, 0x12, 0xad, 0x55, 0xaf, 0x6d, 0x4d, 0xef, 0x6d, 0x6c, 0xf7, 0xaf, 0xcc,
+	0xed, 0xe3, 0xbc, 0xc4, 0xc1, 0xb8, 0x2e, 0x47, 0x00, 0x87, 0xb4, 0xa5, 0xee, 0x3c, 0xc6, 0xf3,
+	0xaa, 0x3e, 0xa5, 0x75, 0xdb, 0x79, 0x8c, 0x0f, 0xb2, 0x5c, 0x46, 0x01, 0x28, 0x0d, 0x53, 0x25,
+	0xda, 0x30, 0x09, 0xad, 0x67, 0x13, 0x3a, 0xe2, 0x23, 0xf3, 0x59, 0xc8, 0x0b, 0x4b, 0xf8, 0x16,
+	0x6c, 0xd8, 0xa7, 0xd6, 0xf5, 0x55, 0xd8, 0x24, 0x3c, 0xa6, 0x99, 0xf6, 0xaa, 0x5c, 0xa9, 0xbd,
+	0x56, 0xad, 0xa8, 0x69, 0xaa, 0x9a, 0x91, 0xec, 0xb7, 0xd6, 0x1d, 0x30, 0xeb, 0x42, 0xb6, 0xc8,
+	0x05, 0x26, 0x31, 0x45, 0x9b, 0xe6, 0x6f, 0x17, 0xad, 0xf5, 0x05, 0xb9, 0xd8, 0x8f, 0xa9, 0xbe,
+	0x6c, 0xbc, 0x17, 0xe3, 0x58, 0xb6, 0x49, 0xc8, 0x78, 0x8c, 0xaa, 0xf6, 0xb2, 0x71, 0x9e, 0x8c,
+	0xd7, 0xad, 0x5d, 0x77, 0xa2, 0x68, 0x48, 0x9a, 0x76, 0x87, 0x2f, 0xda, 0x9b, 0x86, 0x52, 0xf6,
+	0xf0, 0xe0, 0xfc, 0x39, 0x04, 0x9b, 0x3a, 0x09, 0x91, 0xea, 0xab, 0x54, 0xa5, 0x44, 0x89, 0x54,
+	0xe2, 0x36, 0x4d, 0xb5, 0x08, 0x8b, 0xf4, 0x4f, 0x74, 0xcb, 0xf0, 0xd7, 0x5b, 0xe4, 0xe2, 0x38,
+	0xeb, 0x75, 0x42, 0xd3, 0xd7, 0xde, 0x07, 0x3e, 0x03, 0xf3, 0xee, 0x12, 0xf5, 0xff, 0x52, 0xa2,
+	0xdb, 0xa6, 0x2c, 0xab, 0xd9, 0xb2, 0xd8, 0xdb, 0xd4, 0xbb, 0xb8, 0xa2, 0xcc, 0x35, 0x06, 0xac,
+	0x66, 0xc7, 0xb1, 0x62, 0x92, 0xbd, 0xa3, 0xe8, 0x8e, 0x09, 0x3f, 0x65, 0x2c, 0x75, 0xf6, 0x8e,
+	0xc2, 0xe7, 0x60, 0x41, 0x89, 0x33, 0xca, 0x71, 0xdf, 0x49, 0xa2, 0xbb, 0xc3, 0xc1, 0x4e, 0xb5,
+	0xd3, 0x81, 0xa7, 0xf9, 0x60, 0x6a, 0xc0, 0x2a, 0x75, 0x4f, 0x91, 0x8e, 0x12, 0x4e, 0x4c, 0xa5,
+	0x2c, 0x8e, 0x69, 0x2a, 0xd1, 0xbd, 0xe1, 0x9e, 0xda, 0xef, 0x28, 0x61, 0xff, 0x80, 0x75, 0xf2,
+	0x3d, 0x45, 0x72, 0x76, 0x09, 0x1f, 0x01, 0xa4, 0x27, 0xf8, 0x19, 0xbd, 0xc4, 0xa9, 0x70, 0xb3,
+	0xcb, 0x6f, 0x17, 0x7f, 0xb6, 0xd5, 0xa0, 0xaa, 0xf9, 0x8c, 0x5e, 0x06, 0x0e, 0xf5, 0x0b, 0x86,
+	0x00, 0xeb, 0xbd, 0x47, 0x2e, 0x4e, 0x44, 0x83, 0x24, 0xae, 0x88, 0xb6, 0xd5, 0xd1, 0x96, 0xde,
+	0xc8, 0x7e, 0x57, 0x2f, 0x1e, 0x71, 0x15, 0xac, 0x78, 0xcd, 0x27, 0x46, 0xd2, 0x56, 0xde, 0xb4,
+	0xff, 0x3f, 0xc7, 0xbf, 0xfb, 0xb9, 0x3a, 0x76, 0xeb, 0x87, 0x29, 0x50, 0x7c, 0x62, 0xf7, 0xe1,
+	0xba, 0x22, 0x8a, 0xc2, 0xbf, 0x80, 0x89, 0xb6, 0xd9, 0x37, 0xcd, 0x86, 0x39, 0xbd, 0x07, 0xb3,
+	0xe7, 0x60, 0x37, 0xd1, 0xc0, 0x79, 0xc0, 0x6d, 0x50, 0x4a, 0x88, 0x54, 0xb8, 0xd7, 0x7e, 0x5c,
+	0xf0, 0x90, 0x9a, 0x8d, 0x73, 0x3c, 0x58, 0xd0, 0xd0, 0xb1, 0x43, 0xbe, 0xd0, 0x00, 0xbc, 0x0f,
+	0x26, 0x5d, 0x83, 0xa2, 0x6b, 0xd5, 0x6b, 0x79, 0x71, 0xdb, 0x9d, 0x81, 0x77, 0x81, 0x87, 0x60,
+	0xce, 0x7e, 0x35, 0xb7, 0x3a, 0x4b, 0x5b, 0x7a, 0x2d, 0x1d, 0x2a, 0xcd, 0x0b, 0xe9, 0xae, 0xd5,
+	0x9a, 0x75, 0x0a, 0x66, 0xbb, 0xd9, 0x9f, 0x12, 0xfe, 0x0d, 0x4c, 0xba, 0x55, 0x12, 0x5d, 0x37,
+	0xf4, 0xb5, 0xdc, 0xf8, 0x8a, 0x05, 0xe3, 0xf1, 0xe9, 0x85, 0xa9, 0x63, 0xe0, 0x7d, 0xe1, 0x53,
+	0x30, 0xeb, 0x66, 0xae, 0x0f, 0x3e, 0x31, 0xcc, 0x7e, 0x21, 0x63, 0x17, 0xc7, 0xb0, 0x5d, 0x5b,
+	0xcc, 0xd8, 0x79, 0xec, 0x13, 0xf8, 0x0f, 0x98, 0xce, 0x5c, 0xb4, 0x68, 0x72, 0xe4, 0x0c, 0x35,
+	0x49, 0xf4, 0x6e, 0xbf, 0x00, 0x24, 0xfe, 0xab, 0x84, 0xaf, 0x40, 0xa9, 0xcf, 0xef, 0xa7, 0x73,
+	0xc3, 0xe8, 0x6c, 0x8e, 0x4e, 0xa7, 0xa7, 0xe4, 0x3b, 0xb5, 0xa7, 0xd7, 0x4b, 0x6b, 0x1f, 0x14,
+	0x33, 0xf7, 0xa0, 0x44, 0x53, 0x46, 0x6f, 0x79, 0xa0, 0xed, 0xfb, 0xb8, 0xd3, 0x19, 0xa0, 0xc0,
+	0xff, 0x83, 0x99, 0x88, 0x26, 0x34, 0xd6, 0x03, 0xf9, 0x8c, 0x5e, 0x4a, 0x04, 0x8c, 0xc6, 0xdd,
+	0x5c, 0x4e, 0x75, 0xaa, 0xb2, 0xe3, 0xc3, 0xbd, 0x46, 0x04, 0x45, 0xcf, 0x7d, 0x46, 0x2f, 0x25,
+	0xfc, 0x2f, 0x98, 0xa3, 0x69, 0xb8, 0xb7, 0x8b, 0x95, 0xc0, 0x11, 0xe5, 0xa2, 0x25, 0xd1, 0xb4,
+	0x51, 0x43, 0x59, 0xb5, 0xc3, 0xa0, 0xb6, 0xb7, 0x7b, 0x2a, 0x1e, 0x6b, 0x87, 0x60, 0xc6, 0x10,
+	0xdc, 0x2f, 0x09, 0x8f, 0x41, 0xa9, 0xc3, 0x6d, 0xf9, 0x22, 0xac, 0x52, 0xc2, 0xe5, 0x1b, 0xfd,
+	0x38, 0x17, 0x8d, 0x4a, 0x65, 0x64, 0xd1, 0x9d, 0xd3, 0xe9, 0x45, 0x00, 0x7b, 0x54, 0x6f, 0x94,
+	0xf0, 0xeb, 0xde, 0xfb, 0x51, 0x93, 0x7d, 0x4b, 0xc2, 0x33, 0xcc, 0x78, 0xc8, 0x22, 0xca, 0x95,
+	0x44, 0x33, 0x46, 0xb4, 0x3a, 0x30, 0xe0, 0xec, 0x76, 0x60, 0x3c, 0x8f, 0x9c, 0xa3, 0x3b, 0xb5,
+	0x72, 0x63, 0x04, 0xa6, 0x5b, 0x6c, 0xee, 0x6d, 0x87, 0x76, 0x68, 0x7f, 0x83, 0x40, 0xb3, 0x46,
+	0x77, 0x25, 0xab, 0xfb, 0xd2, 0xb8, 0xb8, 0x2d, 0xc2, 0x09, 0xce, 0xbe, 0xcd, 0x1a, 0xa5, 0x9e,
+	0xc1, 0xf9, 0x2b, 0x00, 0xcd, 0x0d, 0x8f, 0xc5, 0xe3, 0x81, 0x7b, 0xc0, 0x8f, 0xc5, 0xdc, 0xed,
+	0xa0, 0x87, 0x6c, 0x7e, 0x84, 0x49, 0x34, 0x3f, 0xac, 0x76, 0x38, 0x30, 0xc7, 0xbc, 0xda, 0xe0,
+	0x74, 0x93, 0x7a, 0x55, 0x36, 0x33, 0xc2, 0xac, 0x79, 0x34, 0xc2, 0xd9, 0xa5, 0xce, 0x8e, 0x0b,
+	0xb7, 0x2a, 0x6b, 0xaf, 0xba, 0x75, 0xca, 0xf4, 0x9d, 0x19, 0x1c, 0x07, 0x2f, 0xdf, 0x7f, 0xaa,
+	0x14, 0x3e, 0x7c, 0xaa, 0x14, 0x7e, 0xfd, 0x54, 0x29, 0x7c, 0xff, 0xb9, 0x32, 0xf6, 0xe1, 0x73,
+	0x65, 0xec, 0xc7, 0xcf, 0x95, 0xb1, 0xaf, 0x1e, 0x0d, 0x0f, 0x42, 0x97, 0xe2, 0x03, 0x7b, 0xe8,
+	0x3b, 0x2d, 0x11, 0x75, 0x12, 0xba, 0x73, 0xe1, 0xed, 0x76, 0x3a, 0x36, 0x26, 0xcc, 0xfb, 0xf5,
+	0x5f, 0x7f, 0x1b, 0x00, 0x34, 0xe6, 0x79, 0xe9, 0x19, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -437,109 +773,348 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.SlashFractionBadEthSignature.Size()
+		size := m.OutboundGlobalWindowLimit.Size()
 		i -= size
-		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.OutboundGlobalWindowLimit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x2
 	i--
-	dAtA[i] = 0x92
-	if m.UnbondSlashingValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+	dAtA[i] = 0xc2
+	if m.EthKeyRotationTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthKeyRotationTimeout))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0xb8
 	}
-	{
-		size := m.SlashFractionConflictingClaim.Size()
-		i -= size
-		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.AutoBatchTriggers) > 0 {
+		for iNdEx := len(m.AutoBatchTriggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoBatchTriggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.SlashFractionClaim.Size()
-		i -= size
-		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TokenBatchSizes) > 0 {
+		for iNdEx := len(m.TokenBatchSizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenBatchSizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.BatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionValset.Size()
-		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.BatchThresholds) > 0 {
+		for iNdEx := len(m.BatchThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+	if m.MaxOrchestratorsPerValidator != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOrchestratorsPerValidator))
 		i--
-		dAtA[i] = 0x60
-	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x90
 	}
-	if m.TargetBatchTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
+	if m.ObservedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ObservedValsetsWindow))
 		i--
-		dAtA[i] = 0x50
-	}
-	if m.SignedClaimsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x88
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+	if m.ValsetMinSpacing != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetMinSpacing))
 		i--
-		dAtA[i] = 0x38
-	}
-	if m.SignedValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x80
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+	if m.ValsetMaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetMaxAge))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xf8
 	}
-	if len(m.ContractSourceHash) > 0 {
+	{
+		size := m.ValsetPowerChangeThreshold.Size()
+		i -= size
+		if _, err := m.ValsetPowerChangeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if len(m.InboundRateLimits) > 0 {
+		for iNdEx := len(m.InboundRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.InboundRateLimitWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InboundRateLimitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.OutboundRateLimits) > 0 {
+		for iNdEx := len(m.OutboundRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.OutboundRateLimitWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutboundRateLimitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.BatchCreationPaused {
+		i--
+		if m.BatchCreationPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.OutboundSendsPaused {
+		i--
+		if m.OutboundSendsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.InboundDepositsPaused {
+		i--
+		if m.InboundDepositsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.BridgeHalted {
+		i--
+		if m.BridgeHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.AttestationVotesPowerThreshold.Size()
+		i -= size
+		if _, err := m.AttestationVotesPowerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.SignedLogicCallsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.SlashFractionBadEthSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.SlashFractionConflictingClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.SlashFractionClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TargetBatchTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SignedClaimsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
 		i -= len(m.ContractSourceHash)
 		copy(dAtA[i:], m.ContractSourceHash)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
@@ -576,6 +1151,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastSlashedAttestationNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedAttestationNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.EthKeyRotations) > 0 {
+		for iNdEx := len(m.EthKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ObservedValsets) > 0 {
+		for iNdEx := len(m.ObservedValsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedValsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.QueuedDeposits) > 0 {
+		for iNdEx := len(m.QueuedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.BridgeHijackIncidents) > 0 {
+		for iNdEx := len(m.BridgeHijackIncidents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeHijackIncidents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.UnbatchedTransfers) > 0 {
 		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -799,26 +1439,103 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.SlashFractionBadEthSignature.Size()
 	n += 2 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SignedLogicCallsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.SignedLogicCallsWindow))
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionLogicCall.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.AttestationVotesPowerThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.BridgeHalted {
+		n += 3
 	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	if m.InboundDepositsPaused {
+		n += 3
 	}
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
+	if m.OutboundSendsPaused {
+		n += 3
+	}
+	if m.BatchCreationPaused {
+		n += 3
+	}
+	if m.OutboundRateLimitWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.OutboundRateLimitWindow))
+	}
+	if len(m.OutboundRateLimits) > 0 {
+		for _, e := range m.OutboundRateLimits {
 			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.InboundRateLimitWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.InboundRateLimitWindow))
+	}
+	if len(m.InboundRateLimits) > 0 {
+		for _, e := range m.InboundRateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ValsetPowerChangeThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ValsetMaxAge != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetMaxAge))
+	}
+	if m.ValsetMinSpacing != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetMinSpacing))
+	}
+	if m.ObservedValsetsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.ObservedValsetsWindow))
+	}
+	if m.MaxOrchestratorsPerValidator != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxOrchestratorsPerValidator))
+	}
+	if len(m.BatchThresholds) > 0 {
+		for _, e := range m.BatchThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BatchSize != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchSize))
+	}
+	if len(m.TokenBatchSizes) > 0 {
+		for _, e := range m.TokenBatchSizes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoBatchTriggers) > 0 {
+		for _, e := range m.AutoBatchTriggers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EthKeyRotationTimeout != 0 {
+		n += 2 + sovGenesis(uint64(m.EthKeyRotationTimeout))
+	}
+	l = m.OutboundGlobalWindowLimit.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
 	if len(m.ValsetConfirms) > 0 {
@@ -875,6 +1592,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BridgeHijackIncidents) > 0 {
+		for _, e := range m.BridgeHijackIncidents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedDeposits) > 0 {
+		for _, e := range m.QueuedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservedValsets) > 0 {
+		for _, e := range m.ObservedValsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthKeyRotations) > 0 {
+		for _, e := range m.EthKeyRotations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSlashedAttestationNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedAttestationNonce))
+	}
 	return n
 }
 
@@ -1047,11 +1791,561 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+			}
+			m.SignedClaimsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationVotesPowerThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestationVotesPowerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeHalted = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDepositsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundDepositsPaused = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSendsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutboundSendsPaused = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCreationPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchCreationPaused = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRateLimitWindow", wireType)
+			}
+			m.OutboundRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundRateLimits = append(m.OutboundRateLimits, OutboundRateLimit{})
+			if err := m.OutboundRateLimits[len(m.OutboundRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRateLimitWindow", wireType)
+			}
+			m.InboundRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedBatchesWindow = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundRateLimits = append(m.InboundRateLimits, InboundRateLimit{})
+			if err := m.InboundRateLimits[len(m.InboundRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPowerChangeThreshold", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1061,16 +2355,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetPowerChangeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMaxAge", wireType)
 			}
-			m.SignedClaimsWindow = 0
+			m.ValsetMaxAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1080,16 +2388,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				m.ValsetMaxAge |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 32:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMinSpacing", wireType)
 			}
-			m.TargetBatchTimeout = 0
+			m.ValsetMinSpacing = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1099,16 +2407,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				m.ValsetMinSpacing |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 33:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedValsetsWindow", wireType)
 			}
-			m.AverageBlockTime = 0
+			m.ObservedValsetsWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1118,16 +2426,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				m.ObservedValsetsWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 34:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrchestratorsPerValidator", wireType)
 			}
-			m.AverageEthereumBlockTime = 0
+			m.MaxOrchestratorsPerValidator = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1137,16 +2445,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				m.MaxOrchestratorsPerValidator |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchThresholds", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1156,30 +2464,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BatchThresholds = append(m.BatchThresholds, BatchThreshold{})
+			if err := m.BatchThresholds[len(m.BatchThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			var byteLen int
+			m.BatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1189,30 +2498,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BatchSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenBatchSizes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1222,30 +2517,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenBatchSizes = append(m.TokenBatchSizes, TokenBatchSize{})
+			if err := m.TokenBatchSizes[len(m.TokenBatchSizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchTriggers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1255,30 +2551,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutoBatchTriggers = append(m.AutoBatchTriggers, AutoBatchTrigger{})
+			if err := m.AutoBatchTriggers[len(m.AutoBatchTriggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 39:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthKeyRotationTimeout", wireType)
 			}
-			m.UnbondSlashingValsetsWindow = 0
+			m.EthKeyRotationTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1288,16 +2585,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				m.EthKeyRotationTimeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundGlobalWindowLimit", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1307,22 +2604,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutboundGlobalWindowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1774,6 +3072,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeHijackIncidents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeHijackIncidents = append(m.BridgeHijackIncidents, BridgeHijackIncident{})
+			if err := m.BridgeHijackIncidents[len(m.BridgeHijackIncidents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedDeposits = append(m.QueuedDeposits, QueuedDeposit{})
+			if err := m.QueuedDeposits[len(m.QueuedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedValsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedValsets = append(m.ObservedValsets, ObservedValset{})
+			if err := m.ObservedValsets[len(m.ObservedValsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthKeyRotations = append(m.EthKeyRotations, EthKeyRotation{})
+			if err := m.EthKeyRotations[len(m.EthKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedAttestationNonce", wireType)
+			}
+			m.LastSlashedAttestationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedAttestationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
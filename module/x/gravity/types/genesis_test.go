@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,6 +22,86 @@ func TestGenesisStateValidate(t *testing.T) {
 				BridgeChainId:         3279089,
 			},
 		}, expErr: true},
+		"attestation threshold at half": {src: withAttestationThreshold(sdk.NewDecWithPrec(5, 1)), expErr: true},
+		"attestation threshold above 1": {src: withAttestationThreshold(sdk.NewDecWithPrec(101, 2)), expErr: true},
+		"attestation threshold at 1":    {src: withAttestationThreshold(sdk.OneDec()), expErr: false},
+		"outbound rate limits": {src: withOutboundRateLimits(
+			OutboundRateLimit{TokenContract: "", WindowLimit: sdk.NewInt(1000), MaxTransfer: sdk.ZeroInt()},
+			OutboundRateLimit{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", WindowLimit: sdk.ZeroInt(), MaxTransfer: sdk.NewInt(10)},
+		), expErr: false},
+		"outbound rate limit invalid contract": {src: withOutboundRateLimits(
+			OutboundRateLimit{TokenContract: "0x1", WindowLimit: sdk.NewInt(1000), MaxTransfer: sdk.ZeroInt()},
+		), expErr: true},
+		"outbound rate limit duplicate contract": {src: withOutboundRateLimits(
+			OutboundRateLimit{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", WindowLimit: sdk.NewInt(1000), MaxTransfer: sdk.ZeroInt()},
+			OutboundRateLimit{TokenContract: "0x429881672b9ae42b8eba0e26cd9c73711b891ca5", WindowLimit: sdk.NewInt(1000), MaxTransfer: sdk.ZeroInt()},
+		), expErr: true},
+		"outbound rate limit negative": {src: withOutboundRateLimits(
+			OutboundRateLimit{WindowLimit: sdk.NewInt(-1), MaxTransfer: sdk.ZeroInt()},
+		), expErr: true},
+		"outbound rate limit nil":                      {src: withOutboundRateLimits(OutboundRateLimit{}), expErr: true},
+		"outbound global window limit":                 {src: withOutboundGlobalWindowLimit(sdk.NewInt(1000)), expErr: false},
+		"outbound global window limit negative":        {src: withOutboundGlobalWindowLimit(sdk.NewInt(-1)), expErr: true},
+		"outbound global window limit nil":             {src: withOutboundGlobalWindowLimit(sdk.Int{}), expErr: true},
+		"valset threshold above 1":                     {src: withValsetTriggers(sdk.NewDecWithPrec(101, 2), 0), expErr: true},
+		"valset threshold negative":                    {src: withValsetTriggers(sdk.NewDecWithPrec(-1, 2), 0), expErr: true},
+		"valset threshold at 0":                        {src: withValsetTriggers(sdk.ZeroDec(), 0), expErr: false},
+		"valset min spacing":                           {src: withValsetTriggers(sdk.NewDecWithPrec(5, 2), 100), expErr: false},
+		"valset min spacing at unbond slashing window": {src: withValsetTriggers(sdk.NewDecWithPrec(5, 2), 10000), expErr: true},
+		"batch thresholds": {src: withBatchThresholds(
+			BatchThreshold{TokenContract: "", MinBatchFee: sdk.ZeroInt(), MinBatchSize: 10},
+			BatchThreshold{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", MinBatchFee: sdk.NewInt(1000)},
+		), expErr: false},
+		"batch threshold invalid contract": {src: withBatchThresholds(
+			BatchThreshold{TokenContract: "0x1", MinBatchFee: sdk.NewInt(1000)},
+		), expErr: true},
+		"batch threshold duplicate contract": {src: withBatchThresholds(
+			BatchThreshold{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", MinBatchFee: sdk.NewInt(1000)},
+			BatchThreshold{TokenContract: "0x429881672b9ae42b8eba0e26cd9c73711b891ca5", MinBatchFee: sdk.NewInt(10)},
+		), expErr: true},
+		"batch threshold negative": {src: withBatchThresholds(BatchThreshold{MinBatchFee: sdk.NewInt(-1)}), expErr: true},
+		"batch threshold nil":      {src: withBatchThresholds(BatchThreshold{}), expErr: true},
+		"token batch sizes": {src: withTokenBatchSizes(
+			TokenBatchSize{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", BatchSize: 10},
+		), expErr: false},
+		"token batch size without contract": {src: withTokenBatchSizes(TokenBatchSize{BatchSize: 10}), expErr: true},
+		"token batch size zero": {src: withTokenBatchSizes(
+			TokenBatchSize{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"},
+		), expErr: true},
+		"token batch size duplicate contract": {src: withTokenBatchSizes(
+			TokenBatchSize{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", BatchSize: 10},
+			TokenBatchSize{TokenContract: "0x429881672b9ae42b8eba0e26cd9c73711b891ca5", BatchSize: 20},
+		), expErr: true},
+		"auto batch triggers": {src: withAutoBatchTriggers(
+			AutoBatchTrigger{TokenContract: "", MinFees: sdk.ZeroInt(), MaxAge: 100},
+			AutoBatchTrigger{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", MinFees: sdk.NewInt(1000)},
+		), expErr: false},
+		"auto batch trigger invalid contract": {src: withAutoBatchTriggers(
+			AutoBatchTrigger{TokenContract: "0x1", MinFees: sdk.NewInt(1000)},
+		), expErr: true},
+		"auto batch trigger duplicate contract": {src: withAutoBatchTriggers(
+			AutoBatchTrigger{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", MinFees: sdk.NewInt(1000)},
+			AutoBatchTrigger{TokenContract: "0x429881672b9ae42b8eba0e26cd9c73711b891ca5", MaxAge: 100, MinFees: sdk.ZeroInt()},
+		), expErr: true},
+		"auto batch trigger negative": {src: withAutoBatchTriggers(AutoBatchTrigger{MinFees: sdk.NewInt(-1)}), expErr: true},
+		"auto batch trigger nil":      {src: withAutoBatchTriggers(AutoBatchTrigger{MaxAge: 100}), expErr: true},
+		"canonical valset": {src: withValsets(NewValset(1, 1, BridgeValidators{
+			{Power: 1, EthereumAddress: "0x0000000000000000000000000000000000000001"},
+			{Power: 2, EthereumAddress: "0x0000000000000000000000000000000000000002"},
+		})), expErr: false},
+		"valset with legacy order of equal powers": {src: withValsets(&Valset{Nonce: 1, Members: []*BridgeValidator{
+			{Power: 1431655765, EthereumAddress: "0xE5904695748fe4A84b40b3fc79De2277660BD1D3"},
+			{Power: 1431655765, EthereumAddress: "0xc783df8a850f42e7F7e57013759C285caa701eB6"},
+			{Power: 1431655765, EthereumAddress: "0xeAD9C93b79Ae7C1591b1FB5323BD777E86e150d4"},
+		}}), expErr: false},
+		"unsorted valset": {src: withValsets(&Valset{Nonce: 1, Members: []*BridgeValidator{
+			{Power: 1, EthereumAddress: "0x0000000000000000000000000000000000000001"},
+			{Power: 2, EthereumAddress: "0x0000000000000000000000000000000000000002"},
+		}}), expErr: true},
+		"observed valsets window zero":                  {src: withObservedValsetsWindow(0), expErr: true},
+		"eth key rotation timeout zero":                 {src: withEthKeyRotationTimeout(0), expErr: true},
+		"last slashed attestation nonce":                {src: withAttestationNonces(5, 3), expErr: false},
+		"last slashed attestation nonce above observed": {src: withAttestationNonces(3, 5), expErr: true},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -34,6 +115,74 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func withAttestationThreshold(threshold sdk.Dec) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.AttestationVotesPowerThreshold = threshold
+	return state
+}
+
+func withOutboundRateLimits(limits ...OutboundRateLimit) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.OutboundRateLimits = limits
+	return state
+}
+
+func withOutboundGlobalWindowLimit(limit sdk.Int) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.OutboundGlobalWindowLimit = limit
+	return state
+}
+
+func withBatchThresholds(thresholds ...BatchThreshold) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.BatchThresholds = thresholds
+	return state
+}
+
+func withTokenBatchSizes(sizes ...TokenBatchSize) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.TokenBatchSizes = sizes
+	return state
+}
+
+func withAutoBatchTriggers(triggers ...AutoBatchTrigger) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.AutoBatchTriggers = triggers
+	return state
+}
+
+func withValsetTriggers(threshold sdk.Dec, minSpacing uint64) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.ValsetPowerChangeThreshold = threshold
+	state.Params.ValsetMinSpacing = minSpacing
+	return state
+}
+
+func withObservedValsetsWindow(window uint64) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.ObservedValsetsWindow = window
+	return state
+}
+
+func withEthKeyRotationTimeout(timeout uint64) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.EthKeyRotationTimeout = timeout
+	return state
+}
+
+func withAttestationNonces(lastObserved, lastSlashed uint64) *GenesisState {
+	state := DefaultGenesisState()
+	state.LastObservedNonce = lastObserved
+	state.LastSlashedAttestationNonce = lastSlashed
+	return state
+}
+
+func withValsets(valsets ...*Valset) *GenesisState {
+	state := DefaultGenesisState()
+	state.Valsets = valsets
+	return state
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
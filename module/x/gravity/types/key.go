@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -30,8 +32,8 @@ var (
 	// ValsetRequestKey indexes valset requests by nonce
 	ValsetRequestKey = []byte{0x3}
 
-	// ValsetConfirmKey indexes valset confirmations by nonce and the validator account address
-	// i.e cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn
+	// ValsetConfirmKey indexes valset confirmations by nonce and the validator address
+	// i.e cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn
 	ValsetConfirmKey = []byte{0x4}
 
 	// OracleClaimKey Claim details by nonce and validator address
@@ -110,6 +112,73 @@ var (
 	// LastSlashedBatchBlock indexes the latest slashed batch block height
 	LastSlashedBatchBlock = []byte{0x19}
 
+	// LastSlashedAttestationNonce indexes the latest slashed attestation event nonce
+	LastSlashedAttestationNonce = []byte{0x1a}
+
+	// LastSlashedLogicCallBlock indexes the latest slashed logic call block height
+	LastSlashedLogicCallBlock = []byte{0x1c}
+
+	// AttestationTallyTriggerKey indexes the last block height at which a vote arrived or the power table changed
+	AttestationTallyTriggerKey = []byte{0x1d}
+
+	// LastAttestationTallyKey indexes the block height of the last attestation tally
+	LastAttestationTallyKey = []byte{0x1e}
+
+	// LastAttestationTallyThresholdKey indexes the attestation votes power threshold used by the last attestation tally
+	LastAttestationTallyThresholdKey = []byte{0x1f}
+
+	// BridgeHijackIncidentKey indexes bridge hijack incidents by the event nonce they were observed at
+	BridgeHijackIncidentKey = []byte{0x20}
+
+	// QueuedDepositKey indexes deposits that are not credited yet by token contract and event nonce
+	QueuedDepositKey = []byte{0x21}
+
+	// OutboundRateLimitUsageKey indexes the amount sent to Ethereum by token contract and block height
+	OutboundRateLimitUsageKey = []byte{0x22}
+
+	// InboundRateLimitUsageKey indexes the amount deposited from Ethereum by token contract and block height
+	InboundRateLimitUsageKey = []byte{0x23}
+
+	// ValsetUpdateRequestKey indexes the last block height at which a staking hook requested a new valset
+	ValsetUpdateRequestKey = []byte{0x24}
+
+	// ObservedValsetKey indexes the archive of observed valsets by Ethereum block height and valset nonce
+	ObservedValsetKey = []byte{0x25}
+
+	// EthKeyRotationKey indexes Ethereum key rotations in their grace period by validator address
+	EthKeyRotationKey = []byte{0x26}
+
+	// DelegateKeysNonceKey indexes the delegate keys nonce by validator address
+	DelegateKeysNonceKey = []byte{0x27}
+
+	// OrchestratorsByValidatorKey indexes the orchestrator keys of a validator
+	OrchestratorsByValidatorKey = []byte{0x28}
+
+	// OutgoingTxBySenderKey indexes the transfers in the pool, including the ones in batches, by sender
+	OutgoingTxBySenderKey = []byte{0x29}
+
+	// OutgoingTxByReceiverKey indexes the transfers in the pool, including the ones in batches, by Ethereum destination
+	OutgoingTxByReceiverKey = []byte{0x2a}
+
+	// OutboundRateLimitTxUsageKey indexes the outbound rate limit usage of the transfers in the pool by id
+	// and block height, so that it can be given back when a transfer is canceled
+	OutboundRateLimitTxUsageKey = []byte{0x2b}
+
+	// QueuedDepositByNonceKey indexes the token contract of queued deposits by event nonce
+	QueuedDepositByNonceKey = []byte{0x2c}
+
+	// QueuedDepositByReceiverKey indexes queued deposits by Cosmos receiver and event nonce
+	QueuedDepositByReceiverKey = []byte{0x2d}
+
+	// AutoBatchRefusedKey indexes the last block height at which an auto batch was refused by token contract
+	AutoBatchRefusedKey = []byte{0x2e}
+
+	// LastClaimsSlashingHeightKey indexes the last block height at which a validator was slashed for missed claims
+	LastClaimsSlashingHeightKey = []byte{0x2f}
+
+	// OutboundGlobalRateLimitUsageKey indexes the amount of all tokens together sent to Ethereum by block height
+	OutboundGlobalRateLimitUsageKey = []byte{0x30}
+
 	// LastUnBondingBlockHeight indexes the last validator unbonding block height
 	LastUnBondingBlockHeight = []byte{0xf8}
 
@@ -140,11 +209,12 @@ func GetEthAddressByValidatorKey(validator sdk.ValAddress) []byte {
 	return append(EthAddressByValidatorKey, validator.Bytes()...)
 }
 
-// GetValidatorByEthAddressKey returns the following key format
+// GetValidatorByEthAddressKey returns the following key format, the eth address is lowercased
+// so that it matches regardless of the checksum casing
 // prefix              cosmos-validator
-// [0xf9][0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B]
+// [0xf9][0xab5801a7d398351b8be11c439e05c5b3259aec9b]
 func GetValidatorByEthAddressKey(ethAddress string) []byte {
-	return append(ValidatorByEthAddressKey, []byte(ethAddress)...)
+	return append(ValidatorByEthAddressKey, []byte(strings.ToLower(ethAddress))...)
 }
 
 // GetValsetKey returns the following key format
@@ -156,9 +226,9 @@ func GetValsetKey(nonce uint64) []byte {
 
 // GetValsetConfirmKey returns the following key format
 // prefix   nonce                    validator-address
-// [0x0][0 0 0 0 0 0 0 1][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+// [0x0][0 0 0 0 0 0 0 1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 // MARK finish-batches: this is where the key is created in the old (presumed working) code
-func GetValsetConfirmKey(nonce uint64, validator sdk.AccAddress) []byte {
+func GetValsetConfirmKey(nonce uint64, validator sdk.ValAddress) []byte {
 	return append(ValsetConfirmKey, append(UInt64Bytes(nonce), validator.Bytes()...)...)
 }
 
@@ -201,6 +271,14 @@ func GetAttestationKey(eventNonce uint64, claimHash []byte) []byte {
 	return key
 }
 
+// GetAttestationNoncePrefix returns the following key format
+// prefix     nonce
+// [0x5][0 0 0 0 0 0 0 1]
+// It is the common prefix of all attestations at a given event nonce
+func GetAttestationNoncePrefix(eventNonce uint64) []byte {
+	return append(OracleAttestationKey, UInt64Bytes(eventNonce)...)
+}
+
 // GetAttestationKeyWithHash returns the following key format
 // prefix     nonce                             claim-details-hash
 // [0x5][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
@@ -240,8 +318,7 @@ func GetOutgoingTxBatchBlockKey(block uint64) []byte {
 // GetBatchConfirmKey returns the following key format
 // prefix           eth-contract-address                BatchNonce                       Validator-address
 // [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
-// TODO this should be a sdk.ValAddress
-func GetBatchConfirmKey(tokenContract string, batchNonce uint64, validator sdk.AccAddress) []byte {
+func GetBatchConfirmKey(tokenContract string, batchNonce uint64, validator sdk.ValAddress) []byte {
 	a := append(UInt64Bytes(batchNonce), validator.Bytes()...)
 	b := append([]byte(tokenContract), a...)
 	c := append(BatchConfirmKey, b...)
@@ -285,15 +362,160 @@ func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) []
 	return append(a, UInt64Bytes(invalidationNonce)...)
 }
 
-func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validator sdk.AccAddress) []byte {
+func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validator sdk.ValAddress) []byte {
 	interm := append(KeyOutgoingLogicConfirm, invalidationId...)
 	interm = append(interm, UInt64Bytes(invalidationNonce)...)
 	return append(interm, validator.Bytes()...)
 }
 
+// GetBridgeHijackIncidentKey returns the following key format
+// prefix     event nonce
+// [0x20][0 0 0 0 0 0 0 1]
+func GetBridgeHijackIncidentKey(eventNonce uint64) []byte {
+	return append(BridgeHijackIncidentKey, UInt64Bytes(eventNonce)...)
+}
+
+// GetQueuedDepositPrefix returns the following key format
+// prefix     token contract
+// [0x21][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetQueuedDepositPrefix(tokenContract string) []byte {
+	return append(QueuedDepositKey, []byte(tokenContract)...)
+}
+
+// GetQueuedDepositKey returns the following key format
+// prefix     token contract                               event nonce
+// [0x21][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetQueuedDepositKey(tokenContract string, eventNonce uint64) []byte {
+	return append(GetQueuedDepositPrefix(tokenContract), UInt64Bytes(eventNonce)...)
+}
+
+// GetRateLimitUsagePrefix returns the following key format, where prefix is either
+// the OutboundRateLimitUsageKey or the InboundRateLimitUsageKey. Under the
+// OutboundGlobalRateLimitUsageKey the token contract is empty
+// prefix     token contract
+// [0x22][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetRateLimitUsagePrefix(prefix []byte, tokenContract string) []byte {
+	return append(append([]byte{}, prefix...), []byte(tokenContract)...)
+}
+
+// GetRateLimitUsageKey returns the following key format, where prefix is either
+// the OutboundRateLimitUsageKey or the InboundRateLimitUsageKey. Under the
+// OutboundGlobalRateLimitUsageKey the token contract is empty
+// prefix     token contract                               block height
+// [0x22][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetRateLimitUsageKey(prefix []byte, tokenContract string, height uint64) []byte {
+	return append(GetRateLimitUsagePrefix(prefix, tokenContract), UInt64Bytes(height)...)
+}
+
 // GetPastEthSignatureCheckpointKey returns the following key format
 // prefix    checkpoint
 // [0x0][ checkpoint bytes ]
 func GetPastEthSignatureCheckpointKey(checkpoint []byte) []byte {
 	return append(PastEthSignatureCheckpointKey, checkpoint...)
 }
+
+// GetObservedValsetKey returns the following key format
+// prefix     ethereum block height        valset nonce
+// [0x25][0 0 0 0 0 0 0 100][0 0 0 0 0 0 0 1]
+func GetObservedValsetKey(ethereumHeight, valsetNonce uint64) []byte {
+	return append(append(append([]byte{}, ObservedValsetKey...), UInt64Bytes(ethereumHeight)...), UInt64Bytes(valsetNonce)...)
+}
+
+// GetEthKeyRotationKey returns the following key format
+// prefix              cosmos-validator
+// [0x26][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetEthKeyRotationKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, EthKeyRotationKey...), validator.Bytes()...)
+}
+
+// GetDelegateKeysNonceKey returns the following key format
+// prefix              cosmos-validator
+// [0x27][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetDelegateKeysNonceKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, DelegateKeysNonceKey...), validator.Bytes()...)
+}
+
+// GetOrchestratorsByValidatorKey returns the following key format
+// prefix              cosmos-validator                                    orchestrator
+// [0x28][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetOrchestratorsByValidatorKey(validator sdk.ValAddress, orch sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, OrchestratorsByValidatorKey...), validator.Bytes()...), orch.Bytes()...)
+}
+
+// GetOutgoingTxBySenderPrefix returns the following key format
+// prefix              sender
+// [0x29][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetOutgoingTxBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append(append([]byte{}, OutgoingTxBySenderKey...), sender.Bytes()...)
+}
+
+// GetOutgoingTxBySenderKey returns the following key format
+// prefix              sender                                           id
+// [0x29][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func GetOutgoingTxBySenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(GetOutgoingTxBySenderPrefix(sender), UInt64Bytes(id)...)
+}
+
+// GetOutgoingTxByReceiverPrefix returns the following key format, the destination is
+// lowercased so that it matches regardless of the checksum casing
+// prefix     ethereum destination
+// [0x2a][0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7]
+func GetOutgoingTxByReceiverPrefix(receiver string) []byte {
+	return append(append([]byte{}, OutgoingTxByReceiverKey...), []byte(strings.ToLower(receiver))...)
+}
+
+// GetOutgoingTxByReceiverKey returns the following key format
+// prefix     ethereum destination                         id
+// [0x2a][0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7][0 0 0 0 0 0 0 1]
+func GetOutgoingTxByReceiverKey(receiver string, id uint64) []byte {
+	return append(GetOutgoingTxByReceiverPrefix(receiver), UInt64Bytes(id)...)
+}
+
+// GetOutboundRateLimitTxUsagePrefix returns the following key format
+// prefix     id
+// [0x2b][0 0 0 0 0 0 0 1]
+func GetOutboundRateLimitTxUsagePrefix(id uint64) []byte {
+	return append(append([]byte{}, OutboundRateLimitTxUsageKey...), UInt64Bytes(id)...)
+}
+
+// GetOutboundRateLimitTxUsageKey returns the following key format
+// prefix     id                 block height
+// [0x2b][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetOutboundRateLimitTxUsageKey(id uint64, height uint64) []byte {
+	return append(GetOutboundRateLimitTxUsagePrefix(id), UInt64Bytes(height)...)
+}
+
+// GetQueuedDepositByNonceKey returns the following key format
+// prefix     event nonce
+// [0x2c][0 0 0 0 0 0 0 1]
+func GetQueuedDepositByNonceKey(eventNonce uint64) []byte {
+	return append(append([]byte{}, QueuedDepositByNonceKey...), UInt64Bytes(eventNonce)...)
+}
+
+// GetQueuedDepositByReceiverPrefix returns the following key format
+// prefix              receiver
+// [0x2d][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetQueuedDepositByReceiverPrefix(receiver sdk.AccAddress) []byte {
+	return append(append([]byte{}, QueuedDepositByReceiverKey...), receiver.Bytes()...)
+}
+
+// GetQueuedDepositByReceiverKey returns the following key format
+// prefix              receiver                                         event nonce
+// [0x2d][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func GetQueuedDepositByReceiverKey(receiver sdk.AccAddress, eventNonce uint64) []byte {
+	return append(GetQueuedDepositByReceiverPrefix(receiver), UInt64Bytes(eventNonce)...)
+}
+
+// GetAutoBatchRefusedKey returns the following key format
+// prefix     token contract
+// [0x2e][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetAutoBatchRefusedKey(tokenContract string) []byte {
+	return append(append([]byte{}, AutoBatchRefusedKey...), []byte(tokenContract)...)
+}
+
+// GetLastClaimsSlashingHeightKey returns the following key format
+// prefix     validator
+// [0x2f][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetLastClaimsSlashingHeightKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, LastClaimsSlashingHeightKey...), validator.Bytes()...)
+}
@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgValsetConfirm{}
 	_ sdk.Msg = &MsgSendToEth{}
 	_ sdk.Msg = &MsgCancelSendToEth{}
+	_ sdk.Msg = &MsgIncreaseBridgeFee{}
 	_ sdk.Msg = &MsgRequestBatch{}
 	_ sdk.Msg = &MsgConfirmBatch{}
 	_ sdk.Msg = &MsgERC20DeployedClaim{}
@@ -22,14 +23,19 @@ var (
 	_ sdk.Msg = &MsgDepositClaim{}
 	_ sdk.Msg = &MsgWithdrawClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgRotateEthKey{}
+	_ sdk.Msg = &MsgAddOrchestratorAddress{}
+	_ sdk.Msg = &MsgRemoveOrchestratorAddress{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
-func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth string) *MsgSetOrchestratorAddress {
+func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth string, nonce uint64, ethSignature []byte) *MsgSetOrchestratorAddress {
 	return &MsgSetOrchestratorAddress{
 		Validator:    val.String(),
 		Orchestrator: oper.String(),
 		EthAddress:   eth,
+		Nonce:        nonce,
+		EthSignature: hex.EncodeToString(ethSignature),
 	}
 }
 
@@ -41,6 +47,21 @@ func (msg *MsgSetOrchestratorAddress) Type() string { return "set_operator_addre
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
+	if err := msg.ValidateDelegateKeys(); err != nil {
+		return err
+	}
+	if len(msg.EthSignature) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "ethereum signature")
+	}
+	if _, err := hex.DecodeString(msg.EthSignature); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "could not hex decode ethereum signature: %s", msg.EthSignature)
+	}
+	return nil
+}
+
+// ValidateDelegateKeys checks the addresses only, delegate keys in genesis state don't carry
+// the Ethereum signature since it was verified when they were set
+func (msg *MsgSetOrchestratorAddress) ValidateDelegateKeys() (err error) {
 	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
 	}
@@ -58,13 +79,21 @@ func (msg *MsgSetOrchestratorAddress) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
-// GetSigners defines whose signature is required
+// GetSigners defines whose signature is required, the orchestrator co-signs to consent
+// to being delegated to
 func (msg *MsgSetOrchestratorAddress) GetSigners() []sdk.AccAddress {
 	acc, err := sdk.ValAddressFromBech32(msg.Validator)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{sdk.AccAddress(acc)}
+	orch, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	if orch.Equals(sdk.AccAddress(acc)) {
+		return []sdk.AccAddress{orch}
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc), orch}
 }
 
 // NewMsgValsetConfirm returns a new msgValsetConfirm
@@ -653,6 +682,46 @@ func (msg *MsgCancelSendToEth) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgIncreaseBridgeFee returns a new msgIncreaseBridgeFee
+func NewMsgIncreaseBridgeFee(user sdk.AccAddress, id uint64, additionalFee sdk.Coin) *MsgIncreaseBridgeFee {
+	return &MsgIncreaseBridgeFee{
+		Sender:        user.String(),
+		TransactionId: id,
+		AdditionalFee: additionalFee,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgIncreaseBridgeFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgIncreaseBridgeFee) Type() string { return "increase_bridge_fee" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgIncreaseBridgeFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if !msg.AdditionalFee.IsValid() || msg.AdditionalFee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "additional fee")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgIncreaseBridgeFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgIncreaseBridgeFee) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
 // MsgSubmitBadSignatureEvidence
 // ======================================================
 
@@ -676,3 +745,132 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// NewMsgRotateEthKey returns a new msgRotateEthKey
+func NewMsgRotateEthKey(val sdk.ValAddress, newEthAddress string, signature []byte) *MsgRotateEthKey {
+	return &MsgRotateEthKey{
+		Validator:     val.String(),
+		NewEthAddress: newEthAddress,
+		Signature:     hex.EncodeToString(signature),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRotateEthKey) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRotateEthKey) Type() string { return "rotate_eth_key" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgRotateEthKey) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+	if err := ValidateEthAddress(msg.NewEthAddress); err != nil {
+		return sdkerrors.Wrap(err, "new ethereum address")
+	}
+	if _, err := hex.DecodeString(msg.Signature); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "could not hex decode signature: %s", msg.Signature)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRotateEthKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRotateEthKey) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgAddOrchestratorAddress returns a new msgAddOrchestratorAddress
+func NewMsgAddOrchestratorAddress(val sdk.ValAddress, orch sdk.AccAddress) *MsgAddOrchestratorAddress {
+	return &MsgAddOrchestratorAddress{
+		Validator:    val.String(),
+		Orchestrator: orch.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgAddOrchestratorAddress) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgAddOrchestratorAddress) Type() string { return "add_orchestrator_address" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgAddOrchestratorAddress) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAddOrchestratorAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required, the orchestrator co-signs to consent
+// to being delegated to
+func (msg *MsgAddOrchestratorAddress) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	orch, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	if orch.Equals(sdk.AccAddress(acc)) {
+		return []sdk.AccAddress{orch}
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc), orch}
+}
+
+// NewMsgRemoveOrchestratorAddress returns a new msgRemoveOrchestratorAddress
+func NewMsgRemoveOrchestratorAddress(val sdk.ValAddress, orch sdk.AccAddress) *MsgRemoveOrchestratorAddress {
+	return &MsgRemoveOrchestratorAddress{
+		Validator:    val.String(),
+		Orchestrator: orch.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRemoveOrchestratorAddress) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRemoveOrchestratorAddress) Type() string { return "remove_orchestrator_address" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgRemoveOrchestratorAddress) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRemoveOrchestratorAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRemoveOrchestratorAddress) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
@@ -41,14 +41,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // that references a validator in the active set
 // ORCHESTRATOR
 // The orchestrator field is a cosmos1... string  (i.e. sdk.AccAddress) that
-// references the key that is being delegated to
+// references the key that is being delegated to, it replaces all orchestrator
+// keys of the validator. In genesis state there is an entry for every
+// orchestrator key of a validator
 // ETH_ADDRESS
 // This is a hex encoded 0x Ethereum public key that will be used by this validator
 // on Ethereum
+// NONCE
+// The delegate keys nonce of the validator, it starts at 0 and is incremented
+// every time the validator sets its delegate keys. In genesis state it is the
+// nonce the next MsgSetOrchestratorAddress of the validator has to use
+// ETH_SIGNATURE
+// This is a hex encoded signature of the Ethereum key over the validator,
+// orchestrator and nonce, proving that the validator holds the key. The
+// orchestrator proves that it consents by co-signing the transaction
 type MsgSetOrchestratorAddress struct {
 	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	Nonce        uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EthSignature string `protobuf:"bytes,5,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
 func (m *MsgSetOrchestratorAddress) Reset()         { *m = MsgSetOrchestratorAddress{} }
@@ -105,6 +117,20 @@ func (m *MsgSetOrchestratorAddress) GetEthAddress() string {
 	return ""
 }
 
+func (m *MsgSetOrchestratorAddress) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgSetOrchestratorAddress) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
 type MsgSetOrchestratorAddressResponse struct {
 }
 
@@ -141,6 +167,202 @@ func (m *MsgSetOrchestratorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetOrchestratorAddressResponse proto.InternalMessageInfo
 
+// MsgAddOrchestratorAddress
+// this message allows validators to delegate to an additional orchestrator key,
+// for instance one run as a hot standby. Claims and confirms of all the
+// orchestrator keys of a validator count once for the validator. The number of
+// keys is bounded by the max_orchestrators_per_validator parameter
+// VALIDATOR
+// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+// that already set its delegate keys with MsgSetOrchestratorAddress
+// ORCHESTRATOR
+// The orchestrator field is a cosmos1... string (i.e. sdk.AccAddress) that
+// references the key that is being delegated to, it co-signs the transaction
+type MsgAddOrchestratorAddress struct {
+	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+}
+
+func (m *MsgAddOrchestratorAddress) Reset()         { *m = MsgAddOrchestratorAddress{} }
+func (m *MsgAddOrchestratorAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgAddOrchestratorAddress) ProtoMessage()    {}
+func (*MsgAddOrchestratorAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{2}
+}
+func (m *MsgAddOrchestratorAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddOrchestratorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddOrchestratorAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddOrchestratorAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddOrchestratorAddress.Merge(m, src)
+}
+func (m *MsgAddOrchestratorAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddOrchestratorAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddOrchestratorAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddOrchestratorAddress proto.InternalMessageInfo
+
+func (m *MsgAddOrchestratorAddress) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgAddOrchestratorAddress) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+type MsgAddOrchestratorAddressResponse struct {
+}
+
+func (m *MsgAddOrchestratorAddressResponse) Reset()         { *m = MsgAddOrchestratorAddressResponse{} }
+func (m *MsgAddOrchestratorAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddOrchestratorAddressResponse) ProtoMessage()    {}
+func (*MsgAddOrchestratorAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{3}
+}
+func (m *MsgAddOrchestratorAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddOrchestratorAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddOrchestratorAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddOrchestratorAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddOrchestratorAddressResponse.Merge(m, src)
+}
+func (m *MsgAddOrchestratorAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddOrchestratorAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddOrchestratorAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddOrchestratorAddressResponse proto.InternalMessageInfo
+
+// MsgRemoveOrchestratorAddress
+// this message allows validators to revoke one of their orchestrator keys,
+// the last orchestrator key of a validator can't be removed, it can only be
+// replaced with MsgSetOrchestratorAddress
+// VALIDATOR
+// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+// ORCHESTRATOR
+// The orchestrator field is a cosmos1... string (i.e. sdk.AccAddress) of the
+// key that is revoked
+type MsgRemoveOrchestratorAddress struct {
+	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+}
+
+func (m *MsgRemoveOrchestratorAddress) Reset()         { *m = MsgRemoveOrchestratorAddress{} }
+func (m *MsgRemoveOrchestratorAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOrchestratorAddress) ProtoMessage()    {}
+func (*MsgRemoveOrchestratorAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
+}
+func (m *MsgRemoveOrchestratorAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOrchestratorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOrchestratorAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOrchestratorAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOrchestratorAddress.Merge(m, src)
+}
+func (m *MsgRemoveOrchestratorAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOrchestratorAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOrchestratorAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOrchestratorAddress proto.InternalMessageInfo
+
+func (m *MsgRemoveOrchestratorAddress) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgRemoveOrchestratorAddress) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+type MsgRemoveOrchestratorAddressResponse struct {
+}
+
+func (m *MsgRemoveOrchestratorAddressResponse) Reset()         { *m = MsgRemoveOrchestratorAddressResponse{} }
+func (m *MsgRemoveOrchestratorAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOrchestratorAddressResponse) ProtoMessage()    {}
+func (*MsgRemoveOrchestratorAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
+}
+func (m *MsgRemoveOrchestratorAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOrchestratorAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOrchestratorAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOrchestratorAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOrchestratorAddressResponse.Merge(m, src)
+}
+func (m *MsgRemoveOrchestratorAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOrchestratorAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOrchestratorAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOrchestratorAddressResponse proto.InternalMessageInfo
+
 // MsgValsetConfirm
 // this is the message sent by the validators when they wish to submit their
 // signatures over the validator set at a given block height. A validator must
@@ -167,7 +389,7 @@ func (m *MsgValsetConfirm) Reset()         { *m = MsgValsetConfirm{} }
 func (m *MsgValsetConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirm) ProtoMessage()    {}
 func (*MsgValsetConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{2}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgValsetConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,7 +453,7 @@ func (m *MsgValsetConfirmResponse) Reset()         { *m = MsgValsetConfirmRespon
 func (m *MsgValsetConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirmResponse) ProtoMessage()    {}
 func (*MsgValsetConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{3}
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
 }
 func (m *MsgValsetConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +505,7 @@ func (m *MsgSendToEth) Reset()         { *m = MsgSendToEth{} }
 func (m *MsgSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEth) ProtoMessage()    {}
 func (*MsgSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
 }
 func (m *MsgSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +569,7 @@ func (m *MsgSendToEthResponse) Reset()         { *m = MsgSendToEthResponse{} }
 func (m *MsgSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEthResponse) ProtoMessage()    {}
 func (*MsgSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
 }
 func (m *MsgSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,18 +605,23 @@ var xxx_messageInfo_MsgSendToEthResponse proto.InternalMessageInfo
 // looks at the AddToOutgoingPool tx's in the store and generates a batch, also
 // available in the store tied to this message. The validators then grab this
 // batch, sign it, submit the signatures with a MsgConfirmBatch before a relayer
-// can finally submit the batch
+// can finally submit the batch. A batch that would fall below the batch
+// threshold of its token is not created, STRICT makes the message fail instead.
+// MAX_BATCH_SIZE optionally limits the batch to fewer transactions than the
+// batch size of its token, 0 uses the batch size of the token
 // -------------
 type MsgRequestBatch struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Strict       bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	MaxBatchSize uint64 `protobuf:"varint,4,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
 }
 
 func (m *MsgRequestBatch) Reset()         { *m = MsgRequestBatch{} }
 func (m *MsgRequestBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatch) ProtoMessage()    {}
 func (*MsgRequestBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
 }
 func (m *MsgRequestBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,14 +664,30 @@ func (m *MsgRequestBatch) GetDenom() string {
 	return ""
 }
 
+func (m *MsgRequestBatch) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *MsgRequestBatch) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+// BATCH_NONCE is the nonce of the created batch, 0 if no batch was created
 type MsgRequestBatchResponse struct {
+	BatchNonce uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
 }
 
 func (m *MsgRequestBatchResponse) Reset()         { *m = MsgRequestBatchResponse{} }
 func (m *MsgRequestBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchResponse) ProtoMessage()    {}
 func (*MsgRequestBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *MsgRequestBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,6 +716,13 @@ func (m *MsgRequestBatchResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestBatchResponse proto.InternalMessageInfo
 
+func (m *MsgRequestBatchResponse) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
 // MsgConfirmBatch
 // When validators observe a MsgRequestBatch they form a batch by ordering
 // transactions currently in the txqueue in order of highest to lowest fee,
@@ -493,7 +743,7 @@ func (m *MsgConfirmBatch) Reset()         { *m = MsgConfirmBatch{} }
 func (m *MsgConfirmBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatch) ProtoMessage()    {}
 func (*MsgConfirmBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *MsgConfirmBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,7 +814,7 @@ func (m *MsgConfirmBatchResponse) Reset()         { *m = MsgConfirmBatchResponse
 func (m *MsgConfirmBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatchResponse) ProtoMessage()    {}
 func (*MsgConfirmBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgConfirmBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,7 +863,7 @@ func (m *MsgConfirmLogicCall) Reset()         { *m = MsgConfirmLogicCall{} }
 func (m *MsgConfirmLogicCall) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCall) ProtoMessage()    {}
 func (*MsgConfirmLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgConfirmLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +934,7 @@ func (m *MsgConfirmLogicCallResponse) Reset()         { *m = MsgConfirmLogicCall
 func (m *MsgConfirmLogicCallResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCallResponse) ProtoMessage()    {}
 func (*MsgConfirmLogicCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgConfirmLogicCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +982,7 @@ func (m *MsgDepositClaim) Reset()         { *m = MsgDepositClaim{} }
 func (m *MsgDepositClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositClaim) ProtoMessage()    {}
 func (*MsgDepositClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *MsgDepositClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +1060,7 @@ func (m *MsgDepositClaimResponse) Reset()         { *m = MsgDepositClaimResponse
 func (m *MsgDepositClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositClaimResponse) ProtoMessage()    {}
 func (*MsgDepositClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
 func (m *MsgDepositClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +1103,7 @@ func (m *MsgWithdrawClaim) Reset()         { *m = MsgWithdrawClaim{} }
 func (m *MsgWithdrawClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawClaim) ProtoMessage()    {}
 func (*MsgWithdrawClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *MsgWithdrawClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1174,7 @@ func (m *MsgWithdrawClaimResponse) Reset()         { *m = MsgWithdrawClaimRespon
 func (m *MsgWithdrawClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawClaimResponse) ProtoMessage()    {}
 func (*MsgWithdrawClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgWithdrawClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1221,7 @@ func (m *MsgERC20DeployedClaim) Reset()         { *m = MsgERC20DeployedClaim{} }
 func (m *MsgERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaim) ProtoMessage()    {}
 func (*MsgERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
 func (m *MsgERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1313,7 @@ func (m *MsgERC20DeployedClaimResponse) Reset()         { *m = MsgERC20DeployedC
 func (m *MsgERC20DeployedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaimResponse) ProtoMessage()    {}
 func (*MsgERC20DeployedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
 func (m *MsgERC20DeployedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,7 +1356,7 @@ func (m *MsgLogicCallExecutedClaim) Reset()         { *m = MsgLogicCallExecutedC
 func (m *MsgLogicCallExecutedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaim) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
 func (m *MsgLogicCallExecutedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,7 +1427,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Reset()         { *m = MsgLogicCallE
 func (m *MsgLogicCallExecutedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaimResponse) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
 func (m *MsgLogicCallExecutedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1470,7 @@ func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
 func (m *MsgValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaim) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *MsgValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1291,7 +1541,7 @@ func (m *MsgValsetUpdatedClaimResponse) Reset()         { *m = MsgValsetUpdatedC
 func (m *MsgValsetUpdatedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaimResponse) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *MsgValsetUpdatedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1582,7 @@ func (m *MsgCancelSendToEth) Reset()         { *m = MsgCancelSendToEth{} }
 func (m *MsgCancelSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEth) ProtoMessage()    {}
 func (*MsgCancelSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *MsgCancelSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1382,7 +1632,7 @@ func (m *MsgCancelSendToEthResponse) Reset()         { *m = MsgCancelSendToEthRe
 func (m *MsgCancelSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthResponse) ProtoMessage()    {}
 func (*MsgCancelSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{23}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *MsgCancelSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,6 +1661,110 @@ func (m *MsgCancelSendToEthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToEthResponse proto.InternalMessageInfo
 
+// MsgIncreaseBridgeFee
+// This call allows the sender (and only the sender) of a MsgSendToEth to add
+// to its bridge fee while it is not in a batch yet, so that it is picked up
+// sooner without canceling and resubmitting it
+// -------------
+// ADDITIONAL_FEE:
+// the amount added to the bridge fee, in the denom of the transfer. It is
+// taken from the sender like the fee of a MsgSendToEth
+type MsgIncreaseBridgeFee struct {
+	TransactionId uint64     `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Sender        string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	AdditionalFee types.Coin `protobuf:"bytes,3,opt,name=additional_fee,json=additionalFee,proto3" json:"additional_fee"`
+}
+
+func (m *MsgIncreaseBridgeFee) Reset()         { *m = MsgIncreaseBridgeFee{} }
+func (m *MsgIncreaseBridgeFee) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBridgeFee) ProtoMessage()    {}
+func (*MsgIncreaseBridgeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
+}
+func (m *MsgIncreaseBridgeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseBridgeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseBridgeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseBridgeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBridgeFee.Merge(m, src)
+}
+func (m *MsgIncreaseBridgeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseBridgeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBridgeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseBridgeFee proto.InternalMessageInfo
+
+func (m *MsgIncreaseBridgeFee) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *MsgIncreaseBridgeFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgIncreaseBridgeFee) GetAdditionalFee() types.Coin {
+	if m != nil {
+		return m.AdditionalFee
+	}
+	return types.Coin{}
+}
+
+type MsgIncreaseBridgeFeeResponse struct {
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Reset()         { *m = MsgIncreaseBridgeFeeResponse{} }
+func (m *MsgIncreaseBridgeFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBridgeFeeResponse) ProtoMessage()    {}
+func (*MsgIncreaseBridgeFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseBridgeFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBridgeFeeResponse.Merge(m, src)
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBridgeFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseBridgeFeeResponse proto.InternalMessageInfo
+
 // This call allows anyone to submit evidence that a
 // validator has signed a valset, batch, or logic call that never
 // existed. Subject contains the batch, valset, or logic call.
@@ -1423,7 +1777,7 @@ func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSigna
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{24}
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1473,7 +1827,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{25}
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,13 +1856,127 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
-	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
-	proto.RegisterType((*MsgValsetConfirm)(nil), "gravity.v1.MsgValsetConfirm")
-	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "gravity.v1.MsgValsetConfirmResponse")
-	proto.RegisterType((*MsgSendToEth)(nil), "gravity.v1.MsgSendToEth")
-	proto.RegisterType((*MsgSendToEthResponse)(nil), "gravity.v1.MsgSendToEthResponse")
+// MsgRotateEthKey
+// this message allows a validator to replace the Ethereum key it signs valsets,
+// batches and logic calls with. Until a valset that includes the new key is
+// observed on Ethereum the previous key stays valid for confirms, since the
+// Gravity.sol contract only knows the previous key until then
+// VALIDATOR
+// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+// of the validator rotating its key, the validator's operator account signs
+// the message
+// NEW_ETH_ADDRESS
+// The hex encoded 0x Ethereum address of the new key
+// SIGNATURE
+// A hex encoded signature by the new key over the gravity id and the validator
+// address, proving that the validator holds the new key
+type MsgRotateEthKey struct {
+	Validator     string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	NewEthAddress string `protobuf:"bytes,2,opt,name=new_eth_address,json=newEthAddress,proto3" json:"new_eth_address,omitempty"`
+	Signature     string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgRotateEthKey) Reset()         { *m = MsgRotateEthKey{} }
+func (m *MsgRotateEthKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateEthKey) ProtoMessage()    {}
+func (*MsgRotateEthKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
+}
+func (m *MsgRotateEthKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateEthKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateEthKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateEthKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateEthKey.Merge(m, src)
+}
+func (m *MsgRotateEthKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateEthKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateEthKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateEthKey proto.InternalMessageInfo
+
+func (m *MsgRotateEthKey) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgRotateEthKey) GetNewEthAddress() string {
+	if m != nil {
+		return m.NewEthAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateEthKey) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type MsgRotateEthKeyResponse struct {
+}
+
+func (m *MsgRotateEthKeyResponse) Reset()         { *m = MsgRotateEthKeyResponse{} }
+func (m *MsgRotateEthKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateEthKeyResponse) ProtoMessage()    {}
+func (*MsgRotateEthKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{33}
+}
+func (m *MsgRotateEthKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateEthKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateEthKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateEthKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateEthKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateEthKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateEthKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateEthKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateEthKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
+	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
+	proto.RegisterType((*MsgAddOrchestratorAddress)(nil), "gravity.v1.MsgAddOrchestratorAddress")
+	proto.RegisterType((*MsgAddOrchestratorAddressResponse)(nil), "gravity.v1.MsgAddOrchestratorAddressResponse")
+	proto.RegisterType((*MsgRemoveOrchestratorAddress)(nil), "gravity.v1.MsgRemoveOrchestratorAddress")
+	proto.RegisterType((*MsgRemoveOrchestratorAddressResponse)(nil), "gravity.v1.MsgRemoveOrchestratorAddressResponse")
+	proto.RegisterType((*MsgValsetConfirm)(nil), "gravity.v1.MsgValsetConfirm")
+	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "gravity.v1.MsgValsetConfirmResponse")
+	proto.RegisterType((*MsgSendToEth)(nil), "gravity.v1.MsgSendToEth")
+	proto.RegisterType((*MsgSendToEthResponse)(nil), "gravity.v1.MsgSendToEthResponse")
 	proto.RegisterType((*MsgRequestBatch)(nil), "gravity.v1.MsgRequestBatch")
 	proto.RegisterType((*MsgRequestBatchResponse)(nil), "gravity.v1.MsgRequestBatchResponse")
 	proto.RegisterType((*MsgConfirmBatch)(nil), "gravity.v1.MsgConfirmBatch")
@@ -1527,107 +1995,128 @@ func init() {
 	proto.RegisterType((*MsgValsetUpdatedClaimResponse)(nil), "gravity.v1.MsgValsetUpdatedClaimResponse")
 	proto.RegisterType((*MsgCancelSendToEth)(nil), "gravity.v1.MsgCancelSendToEth")
 	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "gravity.v1.MsgCancelSendToEthResponse")
+	proto.RegisterType((*MsgIncreaseBridgeFee)(nil), "gravity.v1.MsgIncreaseBridgeFee")
+	proto.RegisterType((*MsgIncreaseBridgeFeeResponse)(nil), "gravity.v1.MsgIncreaseBridgeFeeResponse")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgRotateEthKey)(nil), "gravity.v1.MsgRotateEthKey")
+	proto.RegisterType((*MsgRotateEthKeyResponse)(nil), "gravity.v1.MsgRotateEthKeyResponse")
 }
 
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0xdb, 0xc6,
-	0x12, 0x37, 0x6d, 0xd9, 0x8e, 0x47, 0x76, 0x9c, 0xf0, 0x39, 0x8e, 0x44, 0x3b, 0x92, 0xcd, 0xc4,
-	0x76, 0x82, 0xf7, 0x4c, 0xc5, 0x7e, 0x28, 0x72, 0x6b, 0x11, 0x7f, 0x04, 0x0d, 0x50, 0xa7, 0xa8,
-	0xdc, 0xa6, 0x40, 0x2f, 0xc4, 0x8a, 0xdc, 0x50, 0x6c, 0x48, 0xae, 0xca, 0x5d, 0xc9, 0xf1, 0x25,
-	0x40, 0x7b, 0x4d, 0x0f, 0x2d, 0x7a, 0x6d, 0xff, 0x84, 0xa2, 0xf7, 0x1e, 0x7b, 0xca, 0xa9, 0x08,
-	0xd0, 0x4b, 0x3f, 0x80, 0xa0, 0x48, 0xfa, 0x87, 0x14, 0xdc, 0x5d, 0xad, 0xf9, 0x25, 0xc7, 0x05,
-	0xdc, 0x93, 0xc4, 0xd9, 0xd9, 0x9d, 0xdf, 0xfc, 0x66, 0x76, 0x66, 0x16, 0xae, 0x78, 0x31, 0x1a,
-	0xf8, 0xec, 0xb8, 0x35, 0xd8, 0x6a, 0x85, 0xd4, 0xa3, 0x56, 0x2f, 0x26, 0x8c, 0xe8, 0x20, 0xc5,
-	0xd6, 0x60, 0xcb, 0x68, 0x38, 0x84, 0x86, 0x84, 0xb6, 0x3a, 0x88, 0xe2, 0xd6, 0x60, 0xab, 0x83,
-	0x19, 0xda, 0x6a, 0x39, 0xc4, 0x8f, 0x84, 0xae, 0xb1, 0xe0, 0x11, 0x8f, 0xf0, 0xbf, 0xad, 0xe4,
-	0x9f, 0x94, 0x2e, 0x7b, 0x84, 0x78, 0x01, 0x6e, 0xa1, 0x9e, 0xdf, 0x42, 0x51, 0x44, 0x18, 0x62,
-	0x3e, 0x89, 0xe4, 0xf9, 0xc6, 0x62, 0xca, 0x2c, 0x3b, 0xee, 0xe1, 0xa1, 0xbc, 0x2e, 0x77, 0xf1,
-	0xaf, 0x4e, 0xff, 0x51, 0x0b, 0x45, 0xc7, 0x62, 0xc9, 0x7c, 0x0a, 0xf5, 0x03, 0xea, 0x1d, 0x62,
-	0xf6, 0x7e, 0xec, 0x74, 0x31, 0x65, 0x31, 0x62, 0x24, 0xbe, 0xeb, 0xba, 0x31, 0xa6, 0x54, 0x5f,
-	0x86, 0x99, 0x01, 0x0a, 0x7c, 0x37, 0x91, 0xd5, 0xb4, 0x15, 0xed, 0xe6, 0x4c, 0xfb, 0x44, 0xa0,
-	0x9b, 0x30, 0x4b, 0x52, 0x9b, 0x6a, 0xe3, 0x5c, 0x21, 0x23, 0xd3, 0x9b, 0x50, 0xc5, 0xac, 0x6b,
-	0x23, 0x71, 0x60, 0x6d, 0x82, 0xab, 0x00, 0x66, 0x5d, 0x69, 0xc2, 0xbc, 0x0e, 0xab, 0x23, 0xed,
-	0xb7, 0x31, 0xed, 0x91, 0x88, 0x62, 0xf3, 0x99, 0x06, 0x97, 0x0e, 0xa8, 0xf7, 0x10, 0x05, 0x14,
-	0xb3, 0x5d, 0x12, 0x3d, 0xf2, 0xe3, 0x50, 0x5f, 0x80, 0xc9, 0x88, 0x44, 0x0e, 0xe6, 0xc0, 0x2a,
-	0x6d, 0xf1, 0x71, 0x2e, 0xa0, 0x12, 0xbf, 0xa9, 0xef, 0x45, 0x88, 0xf5, 0x63, 0x5c, 0xab, 0x08,
-	0xbf, 0x95, 0xc0, 0x34, 0xa0, 0x96, 0x07, 0xa3, 0x90, 0xfe, 0xa8, 0xc1, 0x2c, 0xf7, 0x27, 0x72,
-	0x3f, 0x24, 0xfb, 0xac, 0xab, 0x2f, 0xc2, 0x14, 0xc5, 0x91, 0x8b, 0x87, 0xfc, 0xc9, 0x2f, 0xbd,
-	0x0e, 0x17, 0x12, 0x0c, 0x2e, 0xa6, 0x4c, 0x62, 0x9c, 0xc6, 0xac, 0xbb, 0x87, 0x29, 0xd3, 0xef,
-	0xc0, 0x14, 0x0a, 0x49, 0x3f, 0x62, 0x1c, 0x59, 0x75, 0xbb, 0x6e, 0x89, 0x54, 0xb1, 0x92, 0x54,
-	0xb1, 0x64, 0xaa, 0x58, 0xbb, 0xc4, 0x8f, 0x76, 0x2a, 0xcf, 0x5f, 0x36, 0xc7, 0xda, 0x52, 0x5d,
-	0x7f, 0x1b, 0xa0, 0x13, 0xfb, 0xae, 0x87, 0xed, 0x47, 0x58, 0xe0, 0x3e, 0xc3, 0xe6, 0x19, 0xb1,
-	0xe5, 0x1e, 0xc6, 0xe6, 0x22, 0x2c, 0xa4, 0xb1, 0x2b, 0xa7, 0xde, 0x81, 0xf9, 0x03, 0xea, 0xb5,
-	0xf1, 0x67, 0x7d, 0x4c, 0xd9, 0x0e, 0x62, 0xce, 0x68, 0xb7, 0x16, 0x60, 0xd2, 0xc5, 0x11, 0x09,
-	0xa5, 0x4f, 0xe2, 0xc3, 0xac, 0xc3, 0xd5, 0xdc, 0x01, 0xea, 0xec, 0x1f, 0x34, 0x7e, 0xb8, 0xe4,
-	0x51, 0x1c, 0x5e, 0x1e, 0xd9, 0x35, 0xb8, 0xc8, 0xc8, 0x63, 0x1c, 0xd9, 0x0e, 0x89, 0x58, 0x8c,
-	0x9c, 0x21, 0x6f, 0x73, 0x5c, 0xba, 0x2b, 0x85, 0xfa, 0x35, 0x48, 0x22, 0x69, 0x27, 0xe1, 0xc2,
-	0xb1, 0x8c, 0xed, 0x0c, 0x66, 0xdd, 0x43, 0x2e, 0x28, 0xe4, 0x47, 0xa5, 0x24, 0x3f, 0x32, 0xe1,
-	0x9f, 0xcc, 0x87, 0x5f, 0x38, 0x93, 0x06, 0xac, 0x9c, 0xf9, 0x59, 0x83, 0xff, 0x9c, 0xac, 0xbd,
-	0x47, 0x3c, 0xdf, 0xd9, 0x45, 0x41, 0xa0, 0x6f, 0xc0, 0xbc, 0x1f, 0xc9, 0x8b, 0xe3, 0x93, 0xc8,
-	0xf6, 0x5d, 0x49, 0xdb, 0xc5, 0xb4, 0xf8, 0xbe, 0xab, 0x6f, 0x82, 0x9e, 0x51, 0x14, 0x34, 0x8c,
-	0x73, 0x1a, 0x2e, 0xa7, 0x57, 0x1e, 0x70, 0x4a, 0xfe, 0x75, 0x5f, 0xaf, 0xc1, 0x52, 0x89, 0x3f,
-	0x27, 0xd9, 0x3e, 0xce, 0x83, 0xb7, 0x87, 0x7b, 0x84, 0xfa, 0x6c, 0x37, 0x40, 0x7e, 0xc8, 0x2f,
-	0xd7, 0x00, 0x47, 0xcc, 0x4e, 0x87, 0x10, 0xb8, 0x48, 0x80, 0x5e, 0x85, 0xd9, 0x4e, 0x40, 0x9c,
-	0xc7, 0x76, 0x17, 0xfb, 0x5e, 0x97, 0x49, 0xef, 0xaa, 0x5c, 0xf6, 0x2e, 0x17, 0x95, 0x84, 0x7a,
-	0xa2, 0x2c, 0xd4, 0xf7, 0xd4, 0x45, 0xe1, 0x9e, 0xed, 0x58, 0x49, 0x42, 0xff, 0xfe, 0xb2, 0xb9,
-	0xee, 0xf9, 0xac, 0xdb, 0xef, 0x58, 0x0e, 0x09, 0x5b, 0xb2, 0xca, 0x8a, 0x9f, 0x4d, 0xea, 0x3e,
-	0x96, 0x85, 0xf1, 0x7e, 0xc4, 0xd4, 0xbd, 0xd9, 0x80, 0x79, 0xcc, 0xba, 0x38, 0xc6, 0xfd, 0xd0,
-	0x96, 0x59, 0x2d, 0x98, 0xb8, 0x38, 0x14, 0x1f, 0x8a, 0xec, 0xde, 0x80, 0x79, 0x71, 0x90, 0x1d,
-	0x63, 0x07, 0xfb, 0x03, 0x1c, 0xd7, 0xa6, 0x84, 0xa2, 0x10, 0xb7, 0xa5, 0xb4, 0xc0, 0xfc, 0x74,
-	0x91, 0x79, 0x99, 0x47, 0x69, 0xee, 0x14, 0xaf, 0x3f, 0x89, 0x7a, 0xf7, 0xb1, 0xcf, 0xba, 0x6e,
-	0x8c, 0x8e, 0xce, 0x8f, 0xd8, 0x26, 0x54, 0x3b, 0x49, 0xc6, 0xca, 0x33, 0x26, 0xc4, 0x19, 0x5c,
-	0xf4, 0x60, 0xc4, 0x25, 0xab, 0x94, 0x31, 0x9f, 0xf7, 0x6f, 0xb2, 0xc4, 0x3f, 0x51, 0x26, 0x33,
-	0x3e, 0x28, 0x07, 0xbf, 0x1e, 0x87, 0x2b, 0x07, 0xd4, 0xdb, 0x6f, 0xef, 0x6e, 0xdf, 0xde, 0xc3,
-	0xbd, 0x80, 0x1c, 0x63, 0xf7, 0xfc, 0xbc, 0x5c, 0x85, 0x59, 0x19, 0x26, 0x51, 0x8b, 0x44, 0xf2,
-	0x54, 0x85, 0x6c, 0x2f, 0x11, 0x9d, 0xd5, 0x4f, 0x1d, 0x2a, 0x11, 0x0a, 0x87, 0x17, 0x83, 0xff,
-	0xe7, 0xa5, 0xef, 0x38, 0xec, 0x90, 0x40, 0xc6, 0x5e, 0x7e, 0xe9, 0x06, 0x5c, 0x70, 0xb1, 0xe3,
-	0x87, 0x28, 0xa0, 0x3c, 0xde, 0x95, 0xb6, 0xfa, 0x2e, 0xf0, 0x75, 0xa1, 0x84, 0xaf, 0x26, 0x5c,
-	0x2b, 0xa5, 0x44, 0x91, 0xf6, 0x87, 0xc6, 0x7b, 0xb5, 0xba, 0x86, 0xfb, 0x4f, 0xb0, 0xd3, 0x67,
-	0xe7, 0x49, 0x5c, 0x49, 0x9d, 0x4a, 0xb8, 0x9b, 0x3d, 0x63, 0x9d, 0xaa, 0x8c, 0xaa, 0x53, 0x67,
-	0x49, 0x17, 0x31, 0x08, 0x94, 0x3b, 0xa7, 0x28, 0xf8, 0x4d, 0xe3, 0x79, 0x23, 0x7a, 0xef, 0x47,
-	0x3d, 0x17, 0xfd, 0x23, 0xf7, 0x07, 0x7c, 0x5b, 0xa6, 0xa8, 0x56, 0x85, 0xac, 0x9c, 0xa1, 0x89,
-	0x22, 0x43, 0x6f, 0xc1, 0x74, 0x88, 0xc3, 0x0e, 0x8e, 0x69, 0xad, 0xb2, 0x32, 0x71, 0xb3, 0xba,
-	0xbd, 0x64, 0x9d, 0xcc, 0x74, 0xd6, 0x0e, 0x6f, 0xa5, 0x0f, 0x87, 0x13, 0x52, 0x7b, 0xa8, 0x5b,
-	0x20, 0x60, 0x6a, 0x64, 0xfc, 0x8b, 0xae, 0x29, 0xe7, 0x0f, 0x41, 0x4f, 0x8a, 0x31, 0x8a, 0x1c,
-	0x1c, 0x9c, 0x0c, 0x18, 0x49, 0x26, 0xc7, 0x28, 0xa2, 0xc8, 0x49, 0xb7, 0x96, 0x4a, 0x7b, 0x2e,
-	0x25, 0xbd, 0xef, 0xa6, 0x1a, 0xf6, 0x78, 0xba, 0x61, 0x9b, 0xcb, 0x60, 0x14, 0x0f, 0x55, 0x26,
-	0x43, 0x8e, 0xe9, 0xb0, 0xdf, 0x09, 0x7d, 0xb6, 0x83, 0xdc, 0xc3, 0x61, 0x63, 0xd8, 0x1f, 0xf8,
-	0x2e, 0x4e, 0x28, 0xb3, 0x60, 0x9a, 0xf6, 0x3b, 0x9f, 0x62, 0x87, 0x71, 0xb3, 0xd5, 0xed, 0x05,
-	0x4b, 0xcc, 0x9a, 0xd6, 0x70, 0xd6, 0xb4, 0xee, 0x46, 0xc7, 0xed, 0xa1, 0x52, 0xb6, 0xdd, 0x8c,
-	0xe7, 0xdb, 0xcd, 0x06, 0xac, 0x9d, 0x6a, 0x6e, 0x88, 0x6b, 0xfb, 0xc5, 0x1c, 0x4c, 0x1c, 0x50,
-	0x4f, 0x3f, 0x82, 0xb9, 0xec, 0x50, 0xb8, 0x9c, 0x0e, 0x47, 0x7e, 0x4a, 0x33, 0x6e, 0x9c, 0xb6,
-	0xaa, 0x9c, 0x36, 0xbf, 0xf8, 0xe5, 0xaf, 0x6f, 0xc6, 0x97, 0x4d, 0xa3, 0x95, 0x1a, 0xa7, 0x65,
-	0xee, 0x38, 0xd2, 0x4e, 0x17, 0x66, 0x4e, 0x42, 0x50, 0xcb, 0x1d, 0xab, 0x56, 0x8c, 0x95, 0x51,
-	0x2b, 0xca, 0x58, 0x93, 0x1b, 0xab, 0x9b, 0x57, 0xd3, 0xc6, 0x92, 0xd8, 0xd8, 0x8c, 0xd8, 0x98,
-	0x75, 0x75, 0x0a, 0xb3, 0x99, 0xc9, 0x6b, 0x29, 0x77, 0x64, 0x7a, 0xd1, 0xb8, 0x7e, 0xca, 0xa2,
-	0x32, 0xb9, 0xca, 0x4d, 0x2e, 0x99, 0xf5, 0xb4, 0xc9, 0x58, 0x68, 0xda, 0xbc, 0x17, 0x24, 0x46,
-	0x33, 0x13, 0x59, 0xde, 0x68, 0x7a, 0xb1, 0x60, 0xb4, 0x74, 0x34, 0x2a, 0x35, 0x2a, 0xd9, 0x94,
-	0x46, 0x9f, 0xc2, 0xa5, 0xc2, 0xe4, 0xd4, 0x2c, 0x3f, 0x5b, 0x29, 0x18, 0x1b, 0x6f, 0x50, 0x50,
-	0x00, 0x56, 0x38, 0x00, 0xc3, 0xac, 0x15, 0x00, 0x84, 0x76, 0x90, 0x68, 0x27, 0x4e, 0x67, 0x26,
-	0x99, 0xbc, 0xd3, 0xe9, 0xc5, 0x82, 0xd3, 0xa5, 0x7d, 0xbc, 0xd4, 0x69, 0x57, 0x68, 0xda, 0x0e,
-	0x37, 0x72, 0x04, 0x73, 0xd9, 0x36, 0x9f, 0xcf, 0xe0, 0xcc, 0x6a, 0x21, 0x83, 0xcb, 0xdb, 0x6b,
-	0x69, 0x06, 0x1f, 0x49, 0x55, 0x69, 0xf8, 0x99, 0x06, 0x97, 0xd3, 0xc5, 0x46, 0x58, 0x5f, 0x2d,
-	0xbd, 0x21, 0xe9, 0x72, 0x64, 0xdc, 0x7a, 0xa3, 0x8a, 0xc2, 0x71, 0x93, 0xe3, 0x30, 0xcd, 0x95,
-	0x92, 0x9b, 0xd4, 0x17, 0x1b, 0x24, 0x9a, 0x2f, 0x35, 0xd0, 0x4b, 0xa6, 0x81, 0x3c, 0x9c, 0xa2,
-	0x4a, 0x01, 0xce, 0x29, 0x0d, 0xb4, 0x14, 0x0e, 0x8e, 0x9d, 0xed, 0xdb, 0xb6, 0x2b, 0x37, 0x48,
-	0x38, 0xdf, 0x69, 0xb0, 0x38, 0xa2, 0xcf, 0xae, 0xe5, 0xec, 0x95, 0xab, 0x19, 0x9b, 0x67, 0x52,
-	0x53, 0xd0, 0x36, 0x39, 0xb4, 0x0d, 0x73, 0x2d, 0x0d, 0x8d, 0xa7, 0xa5, 0xed, 0xa0, 0x20, 0xb0,
-	0xb1, 0xdc, 0x25, 0xf1, 0x7d, 0xab, 0xc1, 0xe2, 0x88, 0x37, 0xfb, 0x5a, 0xa1, 0xe4, 0x94, 0xa9,
-	0x15, 0xf0, 0xbd, 0xe1, 0x05, 0xfe, 0x3f, 0x8e, 0x6f, 0xdd, 0xbc, 0x91, 0x2d, 0x53, 0xcc, 0x4e,
-	0xb7, 0xb0, 0xe1, 0x8b, 0x5a, 0xff, 0x5c, 0x83, 0xf9, 0x7c, 0x9f, 0x6a, 0xe4, 0x2f, 0x6a, 0x76,
-	0xdd, 0x58, 0x3f, 0x7d, 0x5d, 0x21, 0x59, 0xe7, 0x48, 0x56, 0xcc, 0x46, 0xe6, 0x1e, 0x73, 0x65,
-	0x3b, 0x5d, 0x37, 0xbf, 0xd7, 0xc0, 0x38, 0xa5, 0x71, 0xe5, 0xd3, 0x66, 0xb4, 0xaa, 0xb1, 0x75,
-	0x66, 0x55, 0x05, 0x72, 0x8b, 0x83, 0xfc, 0xaf, 0x79, 0x2b, 0x43, 0x17, 0xdf, 0x67, 0x77, 0x90,
-	0x6b, 0xab, 0x96, 0x67, 0x63, 0xb9, 0x75, 0xe7, 0x83, 0xe7, 0xaf, 0x1a, 0xda, 0x8b, 0x57, 0x0d,
-	0xed, 0xcf, 0x57, 0x0d, 0xed, 0xab, 0xd7, 0x8d, 0xb1, 0x17, 0xaf, 0x1b, 0x63, 0xbf, 0xbe, 0x6e,
-	0x8c, 0x7d, 0x72, 0xa7, 0xf8, 0x9c, 0x91, 0xa7, 0x6e, 0x8a, 0xb7, 0x7b, 0x2b, 0x24, 0x6e, 0x3f,
-	0xc0, 0xad, 0x27, 0xca, 0x1a, 0x7f, 0xe3, 0x74, 0xa6, 0x78, 0x0f, 0xfe, 0xff, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x55, 0x8e, 0x92, 0x13, 0x8e, 0x12, 0x00, 0x00,
+	// 1758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xe4, 0x48,
+	0x15, 0x1f, 0x27, 0x9d, 0xcc, 0xe4, 0xa5, 0x93, 0xec, 0x98, 0x4c, 0xa6, 0xdb, 0xd3, 0xd3, 0x49,
+	0x9c, 0x8f, 0x4e, 0x04, 0xe9, 0x9e, 0x04, 0xa1, 0x95, 0x38, 0x20, 0x4d, 0x3e, 0x46, 0x44, 0x10,
+	0x10, 0x1d, 0x58, 0x24, 0x24, 0x64, 0xaa, 0xed, 0x1a, 0xb7, 0x89, 0xed, 0x0a, 0x76, 0x75, 0x27,
+	0xbd, 0x12, 0x48, 0x70, 0x42, 0x5a, 0x0e, 0x20, 0x8e, 0xb0, 0x77, 0x2e, 0x88, 0x33, 0x1c, 0x39,
+	0xed, 0x09, 0xad, 0xc4, 0x85, 0x0f, 0x69, 0x85, 0x66, 0xf8, 0x43, 0x90, 0xab, 0xca, 0xd5, 0xfe,
+	0x6a, 0xa7, 0xd1, 0x66, 0x4e, 0x89, 0xdf, 0xfb, 0x95, 0xdf, 0xef, 0x7d, 0xd6, 0x6b, 0xc3, 0x13,
+	0x3b, 0x40, 0x43, 0x87, 0x8e, 0x3a, 0xc3, 0xc3, 0x8e, 0x17, 0xda, 0x61, 0xfb, 0x3a, 0x20, 0x94,
+	0xa8, 0x20, 0xc4, 0xed, 0xe1, 0xa1, 0xd6, 0x34, 0x49, 0xe8, 0x91, 0xb0, 0xd3, 0x43, 0x21, 0xee,
+	0x0c, 0x0f, 0x7b, 0x98, 0xa2, 0xc3, 0x8e, 0x49, 0x1c, 0x9f, 0x63, 0xb5, 0x55, 0x9b, 0xd8, 0x84,
+	0xfd, 0xdb, 0x89, 0xfe, 0x13, 0xd2, 0x86, 0x4d, 0x88, 0xed, 0xe2, 0x0e, 0xba, 0x76, 0x3a, 0xc8,
+	0xf7, 0x09, 0x45, 0xd4, 0x21, 0xbe, 0x78, 0xbf, 0xb6, 0x96, 0x30, 0x4b, 0x47, 0xd7, 0x38, 0x96,
+	0xd7, 0xc5, 0x29, 0xf6, 0xd4, 0x1b, 0xbc, 0xee, 0x20, 0x7f, 0xc4, 0x55, 0xfa, 0x9f, 0x15, 0xa8,
+	0x5f, 0x84, 0xf6, 0x25, 0xa6, 0xdf, 0x0e, 0xcc, 0x3e, 0x0e, 0x69, 0x80, 0x28, 0x09, 0x5e, 0x5a,
+	0x56, 0x80, 0xc3, 0x50, 0x6d, 0xc0, 0xc2, 0x10, 0xb9, 0x8e, 0x15, 0xc9, 0x6a, 0xca, 0x86, 0xb2,
+	0xb7, 0xd0, 0x1d, 0x0b, 0x54, 0x1d, 0xaa, 0x24, 0x71, 0xa8, 0x36, 0xc3, 0x00, 0x29, 0x99, 0xba,
+	0x0e, 0x8b, 0x98, 0xf6, 0x0d, 0xc4, 0x5f, 0x58, 0x9b, 0x65, 0x10, 0xc0, 0xb4, 0x1f, 0x9b, 0x58,
+	0x85, 0x39, 0x9f, 0xf8, 0x26, 0xae, 0x55, 0x36, 0x94, 0xbd, 0x4a, 0x97, 0x3f, 0xa8, 0x5b, 0xb0,
+	0x14, 0x1d, 0x0b, 0x1d, 0xdb, 0x47, 0x74, 0x10, 0xe0, 0xda, 0x1c, 0x7f, 0x37, 0xa6, 0xfd, 0xcb,
+	0x58, 0xa6, 0x6f, 0xc1, 0xe6, 0x44, 0xea, 0x5d, 0x1c, 0x5e, 0x13, 0x3f, 0xc4, 0xfa, 0x0f, 0x99,
+	0x7f, 0x2f, 0x2d, 0xeb, 0x9d, 0xf8, 0x27, 0x38, 0x14, 0xbf, 0x5e, 0x72, 0xf8, 0x11, 0x34, 0x2e,
+	0x42, 0xbb, 0x8b, 0x3d, 0x32, 0xc4, 0xef, 0x86, 0xc6, 0x2e, 0x6c, 0x97, 0x59, 0x90, 0x4c, 0x3e,
+	0x52, 0xe0, 0xbd, 0x8b, 0xd0, 0xfe, 0x00, 0xb9, 0x21, 0xa6, 0x27, 0xc4, 0x7f, 0xed, 0x04, 0xde,
+	0x38, 0x05, 0x4a, 0x32, 0x05, 0xf7, 0x92, 0xdd, 0x06, 0x2c, 0x8c, 0x73, 0x58, 0xe1, 0x9e, 0x49,
+	0x81, 0xae, 0x41, 0x2d, 0x4b, 0x46, 0x32, 0xfd, 0x8b, 0x02, 0x55, 0x96, 0x5d, 0xdf, 0xfa, 0x2e,
+	0x39, 0xa3, 0x7d, 0x75, 0x0d, 0xe6, 0x43, 0xec, 0x5b, 0x38, 0x8e, 0x90, 0x78, 0x52, 0xeb, 0xf0,
+	0x28, 0xe2, 0x60, 0xe1, 0x90, 0x0a, 0x8e, 0x0f, 0x31, 0xed, 0x9f, 0xe2, 0x90, 0xaa, 0xef, 0xc3,
+	0x3c, 0xf2, 0xc8, 0xc0, 0xa7, 0x8c, 0xd9, 0xe2, 0x51, 0xbd, 0xcd, 0x9b, 0xae, 0x1d, 0x35, 0x5d,
+	0x5b, 0x34, 0x5d, 0xfb, 0x84, 0x38, 0xfe, 0x71, 0xe5, 0x93, 0xcf, 0xd6, 0x1f, 0x74, 0x05, 0x5c,
+	0xfd, 0x1a, 0x40, 0x2f, 0x70, 0x2c, 0x1b, 0x1b, 0xaf, 0x31, 0xe7, 0x3d, 0xc5, 0xe1, 0x05, 0x7e,
+	0xe4, 0x15, 0xc6, 0xfa, 0x1a, 0xac, 0x26, 0xb9, 0x4b, 0xa7, 0x7e, 0x0a, 0x2b, 0x2c, 0x4d, 0x3f,
+	0x19, 0xe0, 0x90, 0x1e, 0x23, 0x6a, 0x4e, 0x76, 0x6b, 0x15, 0xe6, 0x2c, 0xec, 0x13, 0x4f, 0xf8,
+	0xc4, 0x1f, 0x18, 0x9a, 0x06, 0x8e, 0xc9, 0x3d, 0x7a, 0xd4, 0x15, 0x4f, 0xea, 0x36, 0x2c, 0x7b,
+	0xe8, 0xd6, 0xe8, 0x45, 0xaf, 0x34, 0x42, 0xe7, 0xc3, 0xb8, 0x9d, 0xaa, 0x1e, 0xba, 0x65, 0x76,
+	0x2e, 0x9d, 0x0f, 0xb1, 0xfe, 0x55, 0x78, 0x9a, 0x31, 0x1f, 0x33, 0x8b, 0x32, 0xc9, 0x0f, 0x27,
+	0x2b, 0x01, 0x98, 0xe8, 0x5b, 0x91, 0x44, 0xff, 0x93, 0xc2, 0xb8, 0x8b, 0x34, 0x71, 0xee, 0xc5,
+	0x85, 0xb3, 0x03, 0xcb, 0x94, 0x5c, 0x61, 0xdf, 0x30, 0x89, 0x4f, 0x03, 0x64, 0xc6, 0x69, 0x59,
+	0x62, 0xd2, 0x13, 0x21, 0x54, 0x9f, 0x03, 0xc4, 0x2d, 0x8e, 0x03, 0x51, 0x3a, 0x0b, 0xa2, 0xbf,
+	0x71, 0xbe, 0xea, 0x2b, 0x05, 0xe5, 0x97, 0xaa, 0xae, 0xb9, 0x6c, 0x75, 0xd5, 0xe1, 0x69, 0x86,
+	0xb0, 0xcc, 0xc3, 0xdf, 0x14, 0xf8, 0xc2, 0x58, 0xf7, 0x4d, 0x62, 0x3b, 0xe6, 0x09, 0x72, 0x5d,
+	0xb5, 0x05, 0x2b, 0x8e, 0x2f, 0x3a, 0xcf, 0x21, 0xbe, 0xe1, 0x58, 0x22, 0x2b, 0xcb, 0x49, 0xf1,
+	0xb9, 0xa5, 0x1e, 0x80, 0x9a, 0x02, 0xf2, 0x30, 0xcc, 0xb0, 0x30, 0x3c, 0x4e, 0x6a, 0x58, 0xf0,
+	0xde, 0xbd, 0xaf, 0xcf, 0xe1, 0x59, 0x81, 0x3f, 0xe3, 0x66, 0x9a, 0x61, 0xc9, 0x3b, 0xc5, 0xd7,
+	0x24, 0x74, 0xe8, 0x89, 0x8b, 0x1c, 0x8f, 0xf5, 0xee, 0x10, 0xfb, 0x34, 0x9d, 0x71, 0x26, 0xe2,
+	0xa4, 0x37, 0xa1, 0xda, 0x73, 0x89, 0x79, 0x65, 0xf4, 0xb1, 0x63, 0xf7, 0xa9, 0xf0, 0x6e, 0x91,
+	0xc9, 0xbe, 0xce, 0x44, 0x05, 0xa9, 0x9e, 0x2d, 0x4a, 0xf5, 0x2b, 0xd9, 0x87, 0xcc, 0xb3, 0xe3,
+	0x76, 0xd4, 0x2f, 0xff, 0xfa, 0x6c, 0x7d, 0xd7, 0x76, 0x68, 0x7f, 0xd0, 0x6b, 0x9b, 0xc4, 0xeb,
+	0x88, 0xeb, 0x90, 0xff, 0x39, 0x08, 0xad, 0x2b, 0x71, 0x83, 0x9d, 0xfb, 0x54, 0xb6, 0x65, 0x0b,
+	0x56, 0x30, 0xed, 0xe3, 0x00, 0x0f, 0x3c, 0x43, 0x34, 0x0d, 0x8f, 0xc4, 0x72, 0x2c, 0xbe, 0xe4,
+	0xcd, 0xd3, 0x82, 0x15, 0xfe, 0x22, 0x23, 0xc0, 0x26, 0x76, 0x86, 0x38, 0xa8, 0xcd, 0x73, 0x20,
+	0x17, 0x77, 0x85, 0x34, 0x17, 0xf9, 0x87, 0x05, 0xb3, 0x95, 0xd7, 0x51, 0x32, 0x76, 0x32, 0xae,
+	0x7f, 0xe5, 0xe3, 0xf4, 0xfb, 0x0e, 0xed, 0x5b, 0x01, 0xba, 0xb9, 0xbf, 0xc0, 0x66, 0xda, 0x71,
+	0x36, 0xdb, 0x8e, 0x05, 0x91, 0xaf, 0x14, 0x45, 0x3e, 0xeb, 0xdf, 0x5c, 0x81, 0x7f, 0x7c, 0x0a,
+	0xa7, 0x7c, 0x90, 0x0e, 0xfe, 0x66, 0x06, 0x9e, 0x5c, 0x84, 0xf6, 0x59, 0xf7, 0xe4, 0xe8, 0xc5,
+	0x29, 0xbe, 0x76, 0xc9, 0x08, 0x5b, 0xf7, 0xe7, 0xe5, 0x26, 0x54, 0x45, 0x9a, 0xf8, 0xa8, 0xe3,
+	0xc5, 0xb3, 0xc8, 0x65, 0xa7, 0x91, 0x68, 0x5a, 0x3f, 0x55, 0xa8, 0xf8, 0xc8, 0x8b, 0x1b, 0x83,
+	0xfd, 0xcf, 0x66, 0xe5, 0xc8, 0xeb, 0x11, 0x57, 0xe4, 0x5e, 0x3c, 0xa9, 0x1a, 0x3c, 0xb2, 0xb0,
+	0xe9, 0x78, 0xc8, 0x0d, 0x59, 0xbe, 0x2b, 0x5d, 0xf9, 0x9c, 0x8b, 0xd7, 0xa3, 0x82, 0x78, 0xad,
+	0xc3, 0xf3, 0xc2, 0x90, 0xc8, 0xa0, 0xfd, 0x9b, 0xef, 0x54, 0xb2, 0x0d, 0xcf, 0x6e, 0xb1, 0x39,
+	0xa0, 0xf7, 0x19, 0xb8, 0x82, 0x39, 0x15, 0xc5, 0xae, 0x3a, 0xe5, 0x9c, 0xaa, 0x4c, 0x9a, 0x53,
+	0xd3, 0x94, 0x0b, 0xdf, 0x78, 0x8a, 0x9d, 0x93, 0x21, 0xf8, 0xa7, 0x02, 0x4f, 0xe4, 0xd5, 0xfe,
+	0xbd, 0x6b, 0x0b, 0xfd, 0x5f, 0xee, 0x0f, 0xd9, 0xb1, 0xd4, 0x50, 0x5d, 0xe4, 0xb2, 0xe2, 0x08,
+	0xcd, 0xe6, 0x23, 0xf4, 0x15, 0x78, 0xe8, 0x61, 0xaf, 0x87, 0x83, 0xb0, 0x56, 0xd9, 0x98, 0xdd,
+	0x5b, 0x3c, 0x7a, 0xd6, 0x1e, 0x2f, 0xdf, 0xed, 0x63, 0x76, 0x53, 0x7f, 0x10, 0xaf, 0x58, 0xdd,
+	0x18, 0x9b, 0x0b, 0xc0, 0xfc, 0xc4, 0xfc, 0xe7, 0x5d, 0x93, 0xce, 0x5f, 0x82, 0x1a, 0x0d, 0x63,
+	0xe4, 0x9b, 0xd8, 0x1d, 0xef, 0x2f, 0x51, 0x25, 0x07, 0xc8, 0x0f, 0x91, 0x99, 0xbc, 0x5a, 0x2a,
+	0xdd, 0xa5, 0x84, 0xf4, 0xdc, 0x4a, 0xec, 0x03, 0x33, 0xc9, 0x7d, 0x40, 0x6f, 0x80, 0x96, 0x7f,
+	0xa9, 0x34, 0xf9, 0xb1, 0xc2, 0x36, 0x8e, 0x73, 0xdf, 0x0c, 0x30, 0x0a, 0xf1, 0x71, 0xbc, 0x89,
+	0x7c, 0x4e, 0xab, 0xea, 0x2b, 0x58, 0x46, 0x96, 0xe5, 0x44, 0x28, 0xe4, 0xb2, 0x65, 0x68, 0xca,
+	0x4d, 0x6a, 0x69, 0x7c, 0x2c, 0x5a, 0x88, 0x9a, 0xd0, 0x28, 0xa2, 0x27, 0xf9, 0x7b, 0x2c, 0xa6,
+	0x97, 0x83, 0x9e, 0xe7, 0xd0, 0x63, 0x64, 0xc9, 0x1d, 0xff, 0x6c, 0xe8, 0x58, 0x38, 0x4a, 0x79,
+	0x1b, 0x1e, 0x86, 0x83, 0xde, 0x8f, 0xb1, 0x49, 0x99, 0x03, 0x8b, 0x47, 0xab, 0x6d, 0xfe, 0xa3,
+	0xa6, 0x1d, 0xff, 0xa8, 0x69, 0xbf, 0xf4, 0x47, 0xdd, 0x18, 0x94, 0xbe, 0x2e, 0x67, 0xb2, 0xd7,
+	0x65, 0x0b, 0x76, 0x4a, 0xcd, 0x49, 0x5e, 0x03, 0xbe, 0xb0, 0x11, 0x8a, 0x28, 0x3e, 0xa3, 0xfd,
+	0x6f, 0xe0, 0xd1, 0x1d, 0xcb, 0xfa, 0x2e, 0xac, 0xf8, 0xf8, 0xc6, 0x48, 0x6e, 0xc5, 0x62, 0xfb,
+	0xf1, 0xf1, 0xcd, 0xd9, 0x84, 0xc5, 0x78, 0xb6, 0x78, 0x75, 0x49, 0x9a, 0x8d, 0x19, 0x1d, 0xfd,
+	0x4e, 0x85, 0xd9, 0x8b, 0xd0, 0x56, 0x6f, 0x60, 0x29, 0xbd, 0xc5, 0x37, 0x92, 0x05, 0x9e, 0x5d,
+	0xab, 0xb5, 0xed, 0x32, 0xad, 0x74, 0x57, 0xff, 0xc5, 0xdf, 0xff, 0xfb, 0xdb, 0x99, 0x86, 0xae,
+	0x75, 0x12, 0xbf, 0x24, 0x45, 0x37, 0x9a, 0xc2, 0x4e, 0x1f, 0x16, 0xc6, 0x45, 0x5d, 0xcb, 0xbc,
+	0x56, 0x6a, 0xb4, 0x8d, 0x49, 0x1a, 0x69, 0x6c, 0x9d, 0x19, 0xab, 0xeb, 0x4f, 0x93, 0xc6, 0xa2,
+	0xba, 0x33, 0x28, 0x89, 0x02, 0xa8, 0x86, 0x50, 0x4d, 0xad, 0xca, 0xcf, 0x32, 0xaf, 0x4c, 0x2a,
+	0xb5, 0xad, 0x12, 0xa5, 0x34, 0xb9, 0xc9, 0x4c, 0x3e, 0xd3, 0xeb, 0x49, 0x93, 0x01, 0x47, 0xf2,
+	0xe5, 0x39, 0x32, 0x9a, 0xda, 0x71, 0xb3, 0x46, 0x93, 0x4a, 0x6d, 0xab, 0x44, 0x59, 0x6e, 0x54,
+	0x44, 0x53, 0x18, 0xfd, 0x19, 0xbc, 0x97, 0xdb, 0x45, 0xd7, 0x8b, 0xdf, 0x2d, 0x01, 0x5a, 0xeb,
+	0x0e, 0x80, 0x24, 0xb0, 0xc1, 0x08, 0x68, 0x7a, 0x2d, 0x47, 0xc0, 0x33, 0xdc, 0x08, 0x1d, 0x39,
+	0x9d, 0xda, 0x0d, 0xb3, 0x4e, 0x27, 0x95, 0xda, 0x56, 0x89, 0xb2, 0xdc, 0x69, 0x8b, 0x23, 0x0d,
+	0x93, 0x19, 0xb9, 0x81, 0xa5, 0xf4, 0xe2, 0x94, 0xad, 0xe0, 0x94, 0x56, 0xdb, 0x2e, 0xd3, 0x96,
+	0x57, 0xf0, 0x8d, 0x80, 0x0a, 0xc3, 0x1f, 0x29, 0xf0, 0x38, 0x39, 0xbe, 0xb9, 0xf5, 0xcd, 0xc2,
+	0x0e, 0x49, 0x0e, 0x78, 0x6d, 0xff, 0x4e, 0x88, 0xe4, 0xb1, 0xc7, 0x78, 0xe8, 0xfa, 0x46, 0x41,
+	0x27, 0x0d, 0xf8, 0x01, 0xc1, 0xe6, 0x57, 0x0a, 0xa8, 0x05, 0xfb, 0x55, 0x96, 0x4e, 0x1e, 0xa2,
+	0xed, 0xdf, 0x09, 0x29, 0xa7, 0x83, 0x03, 0xf3, 0xe8, 0x85, 0x61, 0x89, 0x03, 0x82, 0xce, 0xc7,
+	0x0a, 0xac, 0x4d, 0xd8, 0x5c, 0x76, 0x32, 0xf6, 0x8a, 0x61, 0xda, 0xc1, 0x54, 0x30, 0x49, 0xed,
+	0x80, 0x51, 0x6b, 0xe9, 0x3b, 0x49, 0x6a, 0xac, 0x2c, 0x0d, 0x13, 0xb9, 0xae, 0x81, 0xc5, 0x29,
+	0xc1, 0xef, 0xf7, 0x0a, 0xac, 0x4d, 0xf8, 0x5a, 0xb5, 0x93, 0x1b, 0x39, 0x45, 0x30, 0xed, 0x60,
+	0x2a, 0x98, 0xe4, 0xf7, 0x25, 0xc6, 0x6f, 0x57, 0xdf, 0x4e, 0x8f, 0x29, 0x6a, 0x24, 0x97, 0x82,
+	0x78, 0xd8, 0xab, 0x3f, 0x57, 0x60, 0x25, 0x7b, 0xf3, 0x37, 0xb3, 0x8d, 0x9a, 0xd6, 0x6b, 0xbb,
+	0xe5, 0x7a, 0xc9, 0x64, 0x97, 0x31, 0xd9, 0xd0, 0x9b, 0xa9, 0x3e, 0x66, 0x60, 0x23, 0x39, 0x37,
+	0x7f, 0xa9, 0xc0, 0xe3, 0xfc, 0x26, 0x90, 0x1d, 0xc8, 0x39, 0x84, 0xb6, 0x77, 0x17, 0x42, 0x32,
+	0x69, 0x31, 0x26, 0x9b, 0xfa, 0x7a, 0x92, 0x89, 0x23, 0xe0, 0xc6, 0xf8, 0xd3, 0x89, 0xfa, 0x47,
+	0x05, 0xb4, 0x92, 0x5b, 0x3d, 0x5b, 0xc1, 0x93, 0xa1, 0xda, 0xe1, 0xd4, 0x50, 0xc9, 0xf2, 0x90,
+	0xb1, 0xfc, 0xa2, 0xbe, 0x9f, 0xca, 0x1c, 0x3b, 0x67, 0xf4, 0x90, 0x35, 0xfe, 0xba, 0x68, 0xe0,
+	0x98, 0x10, 0x85, 0x6a, 0xea, 0xb2, 0xcf, 0x5d, 0x39, 0x09, 0xa5, 0xb6, 0x55, 0xa2, 0x2c, 0x1f,
+	0x48, 0x01, 0x43, 0xb2, 0x2d, 0xe1, 0x0a, 0x8f, 0x58, 0x4d, 0x4f, 0xf8, 0x42, 0x99, 0xad, 0xe9,
+	0x62, 0x98, 0x76, 0x30, 0x15, 0xac, 0xbc, 0xa6, 0x91, 0x65, 0x15, 0xd7, 0xf4, 0x1f, 0x14, 0xa8,
+	0x4f, 0xfe, 0x78, 0xb9, 0x97, 0xbb, 0x78, 0x27, 0x20, 0xb5, 0x17, 0xd3, 0x22, 0x25, 0xcf, 0x0e,
+	0xe3, 0xb9, 0xaf, 0xb7, 0xd2, 0xf7, 0x75, 0x74, 0xac, 0x90, 0xea, 0xf1, 0x77, 0x3e, 0x79, 0xd3,
+	0x54, 0x3e, 0x7d, 0xd3, 0x54, 0xfe, 0xf3, 0xa6, 0xa9, 0xfc, 0xfa, 0x6d, 0xf3, 0xc1, 0xa7, 0x6f,
+	0x9b, 0x0f, 0xfe, 0xf1, 0xb6, 0xf9, 0xe0, 0x07, 0xef, 0xe7, 0xbf, 0x35, 0x88, 0x77, 0x1e, 0xf0,
+	0x7a, 0xed, 0x78, 0xc4, 0x1a, 0xb8, 0xb8, 0x73, 0x2b, 0x6d, 0xb1, 0x0f, 0x10, 0xbd, 0x79, 0xb6,
+	0x60, 0x7e, 0xf9, 0x7f, 0x03, 0x00, 0x57, 0xc2, 0x46, 0xfd, 0xd4, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1654,7 +2143,11 @@ type MsgClient interface {
 	LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCallExecutedClaim, opts ...grpc.CallOption) (*MsgLogicCallExecutedClaimResponse, error)
 	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
+	IncreaseBridgeFee(ctx context.Context, in *MsgIncreaseBridgeFee, opts ...grpc.CallOption) (*MsgIncreaseBridgeFeeResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	RotateEthKey(ctx context.Context, in *MsgRotateEthKey, opts ...grpc.CallOption) (*MsgRotateEthKeyResponse, error)
+	AddOrchestratorAddress(ctx context.Context, in *MsgAddOrchestratorAddress, opts ...grpc.CallOption) (*MsgAddOrchestratorAddressResponse, error)
+	RemoveOrchestratorAddress(ctx context.Context, in *MsgRemoveOrchestratorAddress, opts ...grpc.CallOption) (*MsgRemoveOrchestratorAddressResponse, error)
 }
 
 type msgClient struct {
@@ -1773,6 +2266,15 @@ func (c *msgClient) CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth,
 	return out, nil
 }
 
+func (c *msgClient) IncreaseBridgeFee(ctx context.Context, in *MsgIncreaseBridgeFee, opts ...grpc.CallOption) (*MsgIncreaseBridgeFeeResponse, error) {
+	out := new(MsgIncreaseBridgeFeeResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/IncreaseBridgeFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	out := new(MsgSubmitBadSignatureEvidenceResponse)
 	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitBadSignatureEvidence", in, out, opts...)
@@ -1782,6 +2284,33 @@ func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmi
 	return out, nil
 }
 
+func (c *msgClient) RotateEthKey(ctx context.Context, in *MsgRotateEthKey, opts ...grpc.CallOption) (*MsgRotateEthKeyResponse, error) {
+	out := new(MsgRotateEthKeyResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RotateEthKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddOrchestratorAddress(ctx context.Context, in *MsgAddOrchestratorAddress, opts ...grpc.CallOption) (*MsgAddOrchestratorAddressResponse, error) {
+	out := new(MsgAddOrchestratorAddressResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/AddOrchestratorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveOrchestratorAddress(ctx context.Context, in *MsgRemoveOrchestratorAddress, opts ...grpc.CallOption) (*MsgRemoveOrchestratorAddressResponse, error) {
+	out := new(MsgRemoveOrchestratorAddressResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RemoveOrchestratorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
@@ -1796,7 +2325,11 @@ type MsgServer interface {
 	LogicCallExecutedClaim(context.Context, *MsgLogicCallExecutedClaim) (*MsgLogicCallExecutedClaimResponse, error)
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
+	IncreaseBridgeFee(context.Context, *MsgIncreaseBridgeFee) (*MsgIncreaseBridgeFeeResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	RotateEthKey(context.Context, *MsgRotateEthKey) (*MsgRotateEthKeyResponse, error)
+	AddOrchestratorAddress(context.Context, *MsgAddOrchestratorAddress) (*MsgAddOrchestratorAddressResponse, error)
+	RemoveOrchestratorAddress(context.Context, *MsgRemoveOrchestratorAddress) (*MsgRemoveOrchestratorAddressResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1839,9 +2372,21 @@ func (*UnimplementedMsgServer) SetOrchestratorAddress(ctx context.Context, req *
 func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEth not implemented")
 }
+func (*UnimplementedMsgServer) IncreaseBridgeFee(ctx context.Context, req *MsgIncreaseBridgeFee) (*MsgIncreaseBridgeFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseBridgeFee not implemented")
+}
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
+func (*UnimplementedMsgServer) RotateEthKey(ctx context.Context, req *MsgRotateEthKey) (*MsgRotateEthKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateEthKey not implemented")
+}
+func (*UnimplementedMsgServer) AddOrchestratorAddress(ctx context.Context, req *MsgAddOrchestratorAddress) (*MsgAddOrchestratorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrchestratorAddress not implemented")
+}
+func (*UnimplementedMsgServer) RemoveOrchestratorAddress(ctx context.Context, req *MsgRemoveOrchestratorAddress) (*MsgRemoveOrchestratorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrchestratorAddress not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2063,6 +2608,24 @@ func _Msg_CancelSendToEth_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_IncreaseBridgeFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseBridgeFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseBridgeFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/IncreaseBridgeFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseBridgeFee(ctx, req.(*MsgIncreaseBridgeFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitBadSignatureEvidence)
 	if err := dec(in); err != nil {
@@ -2081,6 +2644,60 @@ func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateEthKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateEthKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateEthKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RotateEthKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateEthKey(ctx, req.(*MsgRotateEthKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddOrchestratorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddOrchestratorAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddOrchestratorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/AddOrchestratorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddOrchestratorAddress(ctx, req.(*MsgAddOrchestratorAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveOrchestratorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOrchestratorAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOrchestratorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RemoveOrchestratorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOrchestratorAddress(ctx, req.(*MsgRemoveOrchestratorAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2133,10 +2750,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSendToEth",
 			Handler:    _Msg_CancelSendToEth_Handler,
 		},
+		{
+			MethodName: "IncreaseBridgeFee",
+			Handler:    _Msg_IncreaseBridgeFee_Handler,
+		},
 		{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
 		},
+		{
+			MethodName: "RotateEthKey",
+			Handler:    _Msg_RotateEthKey_Handler,
+		},
+		{
+			MethodName: "AddOrchestratorAddress",
+			Handler:    _Msg_AddOrchestratorAddress_Handler,
+		},
+		{
+			MethodName: "RemoveOrchestratorAddress",
+			Handler:    _Msg_RemoveOrchestratorAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -2162,6 +2795,18 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -2209,7 +2854,7 @@ func (m *MsgSetOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddOrchestratorAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2219,30 +2864,16 @@ func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddOrchestratorAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -2250,15 +2881,17 @@ func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2268,12 +2901,12 @@ func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddOrchestratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2281,7 +2914,7 @@ func (m *MsgValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveOrchestratorAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2291,54 +2924,34 @@ func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendToEth) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveOrchestratorAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.EthDest) > 0 {
-		i -= len(m.EthDest)
-		copy(dAtA[i:], m.EthDest)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthDest)))
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2348,12 +2961,12 @@ func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveOrchestratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2361,7 +2974,7 @@ func (m *MsgSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
+func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2371,29 +2984,196 @@ func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBatch) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendToEth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EthDest) > 0 {
+		i -= len(m.EthDest)
+		copy(dAtA[i:], m.EthDest)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthDest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -2418,6 +3198,11 @@ func (m *MsgRequestBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -3059,6 +3844,74 @@ func (m *MsgCancelSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgIncreaseBridgeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBridgeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBridgeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AdditionalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TransactionId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TransactionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3124,26 +3977,93 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRotateEthKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetOrchestratorAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRotateEthKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateEthKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewEthAddress) > 0 {
+		i -= len(m.NewEthAddress)
+		copy(dAtA[i:], m.NewEthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewEthAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateEthKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateEthKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateEthKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetOrchestratorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
 	}
 	l = len(m.Orchestrator)
 	if l > 0 {
@@ -3153,6 +4073,13 @@ func (m *MsgSetOrchestratorAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -3165,6 +4092,58 @@ func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddOrchestratorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddOrchestratorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOrchestratorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOrchestratorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgValsetConfirm) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3242,6 +4221,12 @@ func (m *MsgRequestBatch) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	if m.Strict {
+		n += 2
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovMsgs(uint64(m.MaxBatchSize))
+	}
 	return n
 }
 
@@ -3251,6 +4236,9 @@ func (m *MsgRequestBatchResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
 	return n
 }
 
@@ -3549,6 +4537,33 @@ func (m *MsgCancelSendToEthResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgIncreaseBridgeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionId != 0 {
+		n += 1 + sovMsgs(uint64(m.TransactionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3575,6 +4590,36 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateEthKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.NewEthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateEthKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3706,6 +4751,57 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3783,7 +4879,7 @@ func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgAddOrchestratorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3806,34 +4902,15 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddOrchestratorAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3861,11 +4938,11 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3893,39 +4970,7 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3951,7 +4996,7 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3974,10 +5019,10 @@ func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddOrchestratorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4004,7 +5049,7 @@ func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOrchestratorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4027,15 +5072,15 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOrchestratorAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4063,11 +5108,11 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4095,73 +5140,7 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthDest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4187,7 +5166,7 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4210,10 +5189,10 @@ func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOrchestratorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4240,7 +5219,7 @@ func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4263,15 +5242,34 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4299,11 +5297,11 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4331,7 +5329,39 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4357,7 +5387,7 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4380,10 +5410,10 @@ func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValsetConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4410,7 +5440,7 @@ func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4433,34 +5463,15 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendToEth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4488,11 +5499,11 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthDest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4520,13 +5531,13 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthSigner = string(dAtA[iNdEx:postIndex])
+			m.EthDest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4536,29 +5547,30 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4568,23 +5580,24 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4610,7 +5623,7 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4633,10 +5646,10 @@ func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendToEthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4663,7 +5676,7 @@ func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4686,15 +5699,15 @@ func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmLogicCall: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4722,30 +5735,11 @@ func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationId = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4773,13 +5767,13 @@ func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthSigner = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4789,29 +5783,17 @@ func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			m.Strict = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4821,24 +5803,11 @@ func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4863,7 +5832,7 @@ func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmLogicCallResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4886,12 +5855,31 @@ func (m *MsgConfirmLogicCallResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmLogicCallResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmLogicCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4916,7 +5904,7 @@ func (m *MsgConfirmLogicCallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4939,17 +5927,17 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.EventNonce = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4959,31 +5947,12 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
@@ -5015,43 +5984,9 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5079,11 +6014,11 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			m.EthSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5111,11 +6046,11 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5143,7 +6078,7 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5169,7 +6104,7 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5192,10 +6127,10 @@ func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5222,7 +6157,7 @@ func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5245,17 +6180,17 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmLogicCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5265,16 +6200,29 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			m.BlockHeight = 0
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5284,16 +6232,16 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
 			}
-			m.BatchNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5303,14 +6251,27 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5338,11 +6299,11 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5370,7 +6331,7 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5396,7 +6357,7 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmLogicCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5419,10 +6380,10 @@ func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmLogicCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmLogicCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5449,7 +6410,7 @@ func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5472,10 +6433,10 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5518,7 +6479,7 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5546,11 +6507,11 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5578,11 +6539,13 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5610,11 +6573,11 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5642,28 +6605,9 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
@@ -5719,7 +6663,7 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5742,10 +6686,10 @@ func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5772,7 +6716,7 @@ func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5795,10 +6739,10 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5840,10 +6784,10 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			var byteLen int
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5853,31 +6797,16 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationId == nil {
-				m.InvalidationId = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			m.InvalidationNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5887,11 +6816,24 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
@@ -5948,7 +6890,7 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,10 +6913,10 @@ func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6001,7 +6943,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6024,10 +6966,10 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgERC20DeployedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6051,9 +6993,9 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.ValsetNonce = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6063,16 +7005,16 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetNonce |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6082,16 +7024,29 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6101,27 +7056,108 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
@@ -6177,7 +7213,7 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6200,10 +7236,10 @@ func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6230,7 +7266,7 @@ func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
+func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6253,17 +7289,17 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.TransactionId = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6273,14 +7309,629 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TransactionId |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			m.TransactionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseBridgeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseBridgeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseBridgeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			m.TransactionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6310,6 +7961,39 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6334,7 +8018,7 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIncreaseBridgeFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6357,10 +8041,10 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIncreaseBridgeFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIncreaseBridgeFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6561,6 +8245,208 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateEthKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateEthKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateEthKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateEthKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateEthKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateEthKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
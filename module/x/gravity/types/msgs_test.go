@@ -13,55 +13,70 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 		ethAddress                   = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
 		cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
 		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+		ethSignature                 = bytes.Repeat([]byte{0x1}, 65)
 	)
 	specs := map[string]struct {
 		srcCosmosAddr sdk.AccAddress
 		srcValAddr    sdk.ValAddress
 		srcETHAddr    string
+		srcSignature  []byte
 		expErr        bool
 	}{
 		"all good": {
 			srcCosmosAddr: cosmosAddress,
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcSignature:  ethSignature,
 		},
 		"empty validator address": {
 			srcETHAddr:    ethAddress,
 			srcCosmosAddr: cosmosAddress,
+			srcSignature:  ethSignature,
 			expErr:        true,
 		},
 		"invalid validator address": {
 			srcValAddr:    []byte{0x1},
 			srcCosmosAddr: cosmosAddress,
 			srcETHAddr:    ethAddress,
+			srcSignature:  ethSignature,
 			expErr:        true,
 		},
 		"empty cosmos address": {
-			srcValAddr: valAddress,
-			srcETHAddr: ethAddress,
-			expErr:     true,
+			srcValAddr:   valAddress,
+			srcETHAddr:   ethAddress,
+			srcSignature: ethSignature,
+			expErr:       true,
 		},
 		"invalid cosmos address": {
 			srcCosmosAddr: []byte{0x1},
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcSignature:  ethSignature,
 			expErr:        true,
 		},
 		"empty eth address": {
 			srcValAddr:    valAddress,
 			srcCosmosAddr: cosmosAddress,
+			srcSignature:  ethSignature,
 			expErr:        true,
 		},
 		"invalid eth address": {
 			srcValAddr:    valAddress,
 			srcCosmosAddr: cosmosAddress,
 			srcETHAddr:    "invalid",
+			srcSignature:  ethSignature,
+			expErr:        true,
+		},
+		"empty eth signature": {
+			srcValAddr:    valAddress,
+			srcCosmosAddr: cosmosAddress,
+			srcETHAddr:    ethAddress,
 			expErr:        true,
 		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, spec.srcETHAddr)
+			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, spec.srcETHAddr, 0, spec.srcSignature)
 			// when
 			err := msg.ValidateBasic()
 			if spec.expErr {
@@ -72,6 +72,10 @@ func (m *IDSet) GetIds() []uint64 {
 type BatchFees struct {
 	Token     string                                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	TotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees"`
+	// the number of transactions the next batch of the token would have
+	TxCount uint64 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// whether the next batch of the token would meet its batch threshold
+	MeetsThreshold bool `protobuf:"varint,4,opt,name=meets_threshold,json=meetsThreshold,proto3" json:"meets_threshold,omitempty"`
 }
 
 func (m *BatchFees) Reset()         { *m = BatchFees{} }
@@ -114,31 +118,395 @@ func (m *BatchFees) GetToken() string {
 	return ""
 }
 
+func (m *BatchFees) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BatchFees) GetMeetsThreshold() bool {
+	if m != nil {
+		return m.MeetsThreshold
+	}
+	return false
+}
+
+// BatchThreshold is the minimum total fee and number of transactions of a batch
+// of a token. A threshold without a token contract applies to every token that
+// has no threshold of its own. Zero values are not enforced
+type BatchThreshold struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	MinBatchFee   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_batch_fee,json=minBatchFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_batch_fee"`
+	MinBatchSize  uint64                                 `protobuf:"varint,3,opt,name=min_batch_size,json=minBatchSize,proto3" json:"min_batch_size,omitempty"`
+}
+
+func (m *BatchThreshold) Reset()         { *m = BatchThreshold{} }
+func (m *BatchThreshold) String() string { return proto.CompactTextString(m) }
+func (*BatchThreshold) ProtoMessage()    {}
+func (*BatchThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{2}
+}
+func (m *BatchThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchThreshold.Merge(m, src)
+}
+func (m *BatchThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchThreshold proto.InternalMessageInfo
+
+func (m *BatchThreshold) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BatchThreshold) GetMinBatchSize() uint64 {
+	if m != nil {
+		return m.MinBatchSize
+	}
+	return 0
+}
+
+// TokenBatchSize is the maximum number of transactions in a batch of a token
+type TokenBatchSize struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchSize     uint64 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (m *TokenBatchSize) Reset()         { *m = TokenBatchSize{} }
+func (m *TokenBatchSize) String() string { return proto.CompactTextString(m) }
+func (*TokenBatchSize) ProtoMessage()    {}
+func (*TokenBatchSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{3}
+}
+func (m *TokenBatchSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenBatchSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenBatchSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenBatchSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBatchSize.Merge(m, src)
+}
+func (m *TokenBatchSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenBatchSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBatchSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenBatchSize proto.InternalMessageInfo
+
+func (m *TokenBatchSize) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TokenBatchSize) GetBatchSize() uint64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+// AutoBatchTrigger makes the EndBlocker build a batch of a token once the next
+// batch would have MIN_FEES, or once the oldest transfer in the pool has waited
+// more than MAX_AGE blocks. A trigger without a token contract applies to every
+// token that has no trigger of its own. Zero values never trigger
+type AutoBatchTrigger struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	MinFees       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_fees,json=minFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fees"`
+	MaxAge        uint64                                 `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+}
+
+func (m *AutoBatchTrigger) Reset()         { *m = AutoBatchTrigger{} }
+func (m *AutoBatchTrigger) String() string { return proto.CompactTextString(m) }
+func (*AutoBatchTrigger) ProtoMessage()    {}
+func (*AutoBatchTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{4}
+}
+func (m *AutoBatchTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoBatchTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoBatchTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoBatchTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoBatchTrigger.Merge(m, src)
+}
+func (m *AutoBatchTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoBatchTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoBatchTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoBatchTrigger proto.InternalMessageInfo
+
+func (m *AutoBatchTrigger) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *AutoBatchTrigger) GetMaxAge() uint64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+// OutboundRateLimit limits the amount of a token that can be sent to Ethereum,
+// amounts include the bridge fee. A limit without a token contract applies to
+// every token that has no limit of its own. Zero values are not limited
+type OutboundRateLimit struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// the maximum total amount sent within the outbound rate limit window
+	WindowLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=window_limit,json=windowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"window_limit"`
+	// the maximum amount of a single transfer
+	MaxTransfer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_transfer,json=maxTransfer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_transfer"`
+}
+
+func (m *OutboundRateLimit) Reset()         { *m = OutboundRateLimit{} }
+func (m *OutboundRateLimit) String() string { return proto.CompactTextString(m) }
+func (*OutboundRateLimit) ProtoMessage()    {}
+func (*OutboundRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{5}
+}
+func (m *OutboundRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundRateLimit.Merge(m, src)
+}
+func (m *OutboundRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundRateLimit proto.InternalMessageInfo
+
+func (m *OutboundRateLimit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+// InboundRateLimit limits the amount of a token deposited from Ethereum that is
+// credited within the inbound rate limit window. A limit without a token
+// contract applies to every token that has no limit of its own. A zero window
+// limit is not limited
+type InboundRateLimit struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// the maximum total amount credited within the inbound rate limit window
+	WindowLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=window_limit,json=windowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"window_limit"`
+}
+
+func (m *InboundRateLimit) Reset()         { *m = InboundRateLimit{} }
+func (m *InboundRateLimit) String() string { return proto.CompactTextString(m) }
+func (*InboundRateLimit) ProtoMessage()    {}
+func (*InboundRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{6}
+}
+func (m *InboundRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundRateLimit.Merge(m, src)
+}
+func (m *InboundRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundRateLimit proto.InternalMessageInfo
+
+func (m *InboundRateLimit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+// QueuedDeposit is a deposit that has been observed but not credited yet,
+// either because inbound deposits are paused or because crediting it would
+// exceed the inbound rate limit of its token. A deposit above the window limit
+// is credited in parts, its amount is what is left to credit
+type QueuedDeposit struct {
+	Deposit MsgDepositClaim `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit"`
+	// the Cosmos block height the deposit was queued at
+	QueuedHeight uint64 `protobuf:"varint,2,opt,name=queued_height,json=queuedHeight,proto3" json:"queued_height,omitempty"`
+}
+
+func (m *QueuedDeposit) Reset()         { *m = QueuedDeposit{} }
+func (m *QueuedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QueuedDeposit) ProtoMessage()    {}
+func (*QueuedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{7}
+}
+func (m *QueuedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedDeposit.Merge(m, src)
+}
+func (m *QueuedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedDeposit proto.InternalMessageInfo
+
+func (m *QueuedDeposit) GetDeposit() MsgDepositClaim {
+	if m != nil {
+		return m.Deposit
+	}
+	return MsgDepositClaim{}
+}
+
+func (m *QueuedDeposit) GetQueuedHeight() uint64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "gravity.v1.BatchFees")
+	proto.RegisterType((*BatchThreshold)(nil), "gravity.v1.BatchThreshold")
+	proto.RegisterType((*TokenBatchSize)(nil), "gravity.v1.TokenBatchSize")
+	proto.RegisterType((*AutoBatchTrigger)(nil), "gravity.v1.AutoBatchTrigger")
+	proto.RegisterType((*OutboundRateLimit)(nil), "gravity.v1.OutboundRateLimit")
+	proto.RegisterType((*InboundRateLimit)(nil), "gravity.v1.InboundRateLimit")
+	proto.RegisterType((*QueuedDeposit)(nil), "gravity.v1.QueuedDeposit")
 }
 
 func init() { proto.RegisterFile("gravity/v1/pool.proto", fileDescriptor_18d107f7cfc31f22) }
 
 var fileDescriptor_18d107f7cfc31f22 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x2f, 0x4a, 0x2c,
-	0xcb, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x82, 0x0a, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x85,
-	0xf5, 0x41, 0x2c, 0x88, 0x0a, 0x25, 0x49, 0x2e, 0x56, 0x4f, 0x97, 0xe0, 0xd4, 0x12, 0x21, 0x01,
-	0x2e, 0xe6, 0xcc, 0x94, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x96, 0x20, 0x10, 0x53, 0xa9, 0x80,
-	0x8b, 0xd3, 0x29, 0xb1, 0x24, 0x39, 0xc3, 0x2d, 0x35, 0xb5, 0x58, 0x48, 0x84, 0x8b, 0xb5, 0x24,
-	0x3f, 0x3b, 0x35, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc2, 0x11, 0xf2, 0xe5, 0xe2,
-	0x2a, 0xc9, 0x2f, 0x49, 0xcc, 0x89, 0x4f, 0x4b, 0x4d, 0x2d, 0x96, 0x60, 0x02, 0x49, 0x39, 0xe9,
-	0x9d, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x31, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0xf3, 0xcc, 0x2b, 0x09, 0xe2, 0x04, 0x9b, 0x00, 0xb2, 0xc4,
-	0x29, 0xf0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0x31, 0x0d, 0x83,
-	0x7a, 0x4d, 0x37, 0xa9, 0x28, 0x33, 0x25, 0x3d, 0x55, 0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0x55,
-	0xbf, 0x02, 0x26, 0x0e, 0xb1, 0x21, 0x89, 0x0d, 0xec, 0x4d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0x47, 0x60, 0xc4, 0x21, 0x01, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4f, 0x4e, 0xdb, 0x40,
+	0x14, 0xc6, 0x33, 0x24, 0x10, 0xf2, 0x48, 0x52, 0x6a, 0x51, 0x35, 0x50, 0x35, 0x44, 0xee, 0xbf,
+	0x6c, 0xb0, 0x45, 0xbb, 0xe8, 0xa2, 0x2b, 0x02, 0xaa, 0x1a, 0xa9, 0xa8, 0x8a, 0x41, 0x5d, 0x74,
+	0x63, 0x39, 0xf1, 0x30, 0x1e, 0xe1, 0xf1, 0x04, 0xcf, 0x33, 0x18, 0xce, 0xd0, 0x45, 0x2f, 0xd0,
+	0x43, 0x54, 0xea, 0x21, 0x58, 0xb2, 0xac, 0xba, 0x40, 0x08, 0x2e, 0x52, 0x79, 0x6c, 0x03, 0x52,
+	0x37, 0x88, 0x6e, 0xba, 0xca, 0xcc, 0x2f, 0xf3, 0xbe, 0xf7, 0x7d, 0xf3, 0xac, 0x81, 0x47, 0x2c,
+	0xf6, 0x0e, 0x39, 0x1e, 0xdb, 0x87, 0xeb, 0xf6, 0x54, 0xca, 0xd0, 0x9a, 0xc6, 0x12, 0xa5, 0x01,
+	0x05, 0xb6, 0x0e, 0xd7, 0x57, 0x96, 0x98, 0x64, 0x52, 0x63, 0x3b, 0x5b, 0xe5, 0x27, 0x56, 0x6e,
+	0x17, 0x0a, 0xc5, 0x54, 0x8e, 0xcd, 0x65, 0x98, 0x1d, 0x6e, 0xed, 0x50, 0x34, 0x16, 0xa1, 0xca,
+	0x7d, 0xd5, 0x21, 0xbd, 0x6a, 0xbf, 0xe6, 0x64, 0x4b, 0xf3, 0x27, 0x81, 0xc6, 0xc0, 0xc3, 0x49,
+	0xf0, 0x9e, 0x52, 0x65, 0x2c, 0xc1, 0x2c, 0xca, 0x7d, 0x1a, 0x75, 0x48, 0x8f, 0xf4, 0x1b, 0x4e,
+	0xbe, 0x31, 0xb6, 0x01, 0x50, 0xa2, 0x17, 0xba, 0x7b, 0x94, 0xaa, 0xce, 0x4c, 0xf6, 0xd7, 0xc0,
+	0x3a, 0x3d, 0x5f, 0xad, 0xfc, 0x3e, 0x5f, 0x7d, 0xc9, 0x38, 0x06, 0xc9, 0xd8, 0x9a, 0x48, 0x61,
+	0x4f, 0xa4, 0x12, 0x52, 0x15, 0x3f, 0x6b, 0xca, 0xdf, 0xb7, 0xf1, 0x78, 0x4a, 0x95, 0x35, 0x8c,
+	0xd0, 0x69, 0x68, 0x05, 0xdd, 0x64, 0x19, 0xe6, 0x31, 0x75, 0x27, 0x32, 0x89, 0xb0, 0x53, 0xed,
+	0x91, 0x7e, 0xcd, 0xa9, 0x63, 0xba, 0x99, 0x6d, 0x8d, 0x57, 0xf0, 0x40, 0x50, 0x8a, 0xca, 0xc5,
+	0x20, 0xa6, 0x2a, 0x90, 0xa1, 0xdf, 0xa9, 0xf5, 0x48, 0x7f, 0xde, 0x69, 0x6b, 0xbc, 0x5b, 0x52,
+	0xf3, 0x07, 0x81, 0xb6, 0xb6, 0x7d, 0x8d, 0x8c, 0x17, 0xd0, 0xd6, 0x76, 0xdd, 0x89, 0x8c, 0x30,
+	0xf6, 0x26, 0x58, 0x84, 0x68, 0x69, 0xba, 0x59, 0x40, 0xc3, 0x81, 0x96, 0xe0, 0x91, 0x3b, 0xce,
+	0x8a, 0xb3, 0x40, 0xf7, 0xcc, 0xb3, 0x20, 0x78, 0x54, 0xde, 0x9b, 0xf1, 0x1c, 0xda, 0x37, 0x9a,
+	0x8a, 0x9f, 0xd0, 0x22, 0x57, 0xb3, 0x3c, 0xb4, 0xc3, 0x4f, 0xa8, 0xf9, 0x19, 0xda, 0xbb, 0x99,
+	0x95, 0x6b, 0x72, 0x57, 0xcb, 0x4f, 0x01, 0x6e, 0x49, 0xcf, 0x68, 0xe9, 0xc6, 0xf8, 0x5a, 0xf7,
+	0x3b, 0x81, 0xc5, 0x8d, 0x04, 0x65, 0x7e, 0x1f, 0x31, 0x67, 0x8c, 0xc6, 0x77, 0x95, 0x1e, 0xc2,
+	0x7c, 0xe6, 0xfc, 0x1f, 0x06, 0x5b, 0x17, 0x3c, 0xd2, 0x63, 0x7d, 0x0c, 0x75, 0xe1, 0xa5, 0xae,
+	0xc7, 0xca, 0xf4, 0x73, 0xc2, 0x4b, 0x37, 0x18, 0x35, 0x2f, 0x08, 0x3c, 0xfc, 0x94, 0xe0, 0x58,
+	0x26, 0x91, 0xef, 0x78, 0x48, 0x3f, 0x72, 0xc1, 0xf1, 0xae, 0x06, 0x47, 0xd0, 0x3c, 0xe2, 0x91,
+	0x2f, 0x8f, 0xdc, 0x30, 0x2b, 0xbb, 0xef, 0xb4, 0x72, 0x8d, 0xbc, 0xf3, 0x08, 0x9a, 0x99, 0x51,
+	0x8c, 0xbd, 0x48, 0xed, 0xd1, 0xb8, 0x53, 0xbd, 0x9f, 0xa4, 0xf0, 0xd2, 0xdd, 0x42, 0xc2, 0xfc,
+	0x4a, 0x60, 0x71, 0x18, 0xfd, 0x2f, 0x09, 0xcd, 0x03, 0x68, 0x8d, 0x12, 0x9a, 0x50, 0x7f, 0x8b,
+	0x4e, 0xa5, 0xe2, 0x68, 0xbc, 0x83, 0xba, 0x9f, 0x2f, 0xb5, 0x87, 0x85, 0xd7, 0x4f, 0xac, 0x9b,
+	0xb7, 0xc4, 0xda, 0x56, 0xac, 0x38, 0xb8, 0x19, 0x7a, 0x5c, 0x0c, 0x6a, 0x59, 0x6f, 0xa7, 0xac,
+	0x30, 0x9e, 0x41, 0xeb, 0x40, 0xab, 0xb9, 0x01, 0xe5, 0x2c, 0xc0, 0xe2, 0x0b, 0x6c, 0xe6, 0xf0,
+	0x83, 0x66, 0x83, 0xd1, 0xe9, 0x65, 0x97, 0x9c, 0x5d, 0x76, 0xc9, 0xc5, 0x65, 0x97, 0x7c, 0xbb,
+	0xea, 0x56, 0xce, 0xae, 0xba, 0x95, 0x5f, 0x57, 0xdd, 0xca, 0x97, 0xb7, 0x7f, 0x27, 0x28, 0x7a,
+	0xaf, 0x8d, 0x63, 0xee, 0x33, 0x6a, 0x0b, 0xe9, 0x27, 0x21, 0xb5, 0xd3, 0x92, 0xe7, 0xb1, 0xc6,
+	0x73, 0xfa, 0xf1, 0x7a, 0xf3, 0x67, 0x00, 0x6f, 0xd9, 0xf1, 0xac, 0x0e, 0x05, 0x00, 0x00,
 }
 
 func (m *IDSet) Marshal() (dAtA []byte, err error) {
@@ -202,6 +570,21 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MeetsThreshold {
+		i--
+		if m.MeetsThreshold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.TotalFees.Size()
 		i -= size
@@ -222,55 +605,1079 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPool(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IDSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BatchThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovPool(uint64(e))
+	if m.MinBatchSize != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MinBatchSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinBatchFee.Size()
+		i -= size
+		if _, err := m.MinBatchFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovPool(uint64(l)) + l
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchFees) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+func (m *TokenBatchSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TotalFees.Size()
-	n += 1 + l + sovPool(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TokenBatchSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozPool(x uint64) (n int) {
-	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TokenBatchSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchSize != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoBatchTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoBatchTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoBatchTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAge != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinFees.Size()
+		i -= size
+		if _, err := m.MinFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboundRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxTransfer.Size()
+		i -= size
+		if _, err := m.MaxTransfer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.WindowLimit.Size()
+		i -= size
+		if _, err := m.WindowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WindowLimit.Size()
+		i -= size
+		if _, err := m.WindowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedHeight != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.QueuedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IDSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovPool(uint64(e))
+		}
+		n += 1 + sovPool(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BatchFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.TotalFees.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.TxCount != 0 {
+		n += 1 + sovPool(uint64(m.TxCount))
+	}
+	if m.MeetsThreshold {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.MinBatchFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.MinBatchSize != 0 {
+		n += 1 + sovPool(uint64(m.MinBatchSize))
+	}
+	return n
+}
+
+func (m *TokenBatchSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovPool(uint64(m.BatchSize))
+	}
+	return n
+}
+
+func (m *AutoBatchTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.MinFees.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.MaxAge != 0 {
+		n += 1 + sovPool(uint64(m.MaxAge))
+	}
+	return n
+}
+
+func (m *OutboundRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.WindowLimit.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.MaxTransfer.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *InboundRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.WindowLimit.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *QueuedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.QueuedHeight != 0 {
+		n += 1 + sovPool(uint64(m.QueuedHeight))
+	}
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeetsThreshold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MeetsThreshold = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBatchFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBatchFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBatchSize", wireType)
+			}
+			m.MinBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenBatchSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenBatchSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenBatchSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoBatchTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoBatchTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoBatchTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *IDSet) Unmarshal(dAtA []byte) error {
+func (m *OutboundRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -293,88 +1700,112 @@ func (m *IDSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutboundRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutboundRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPool
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPool
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPool
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPool
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransfer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPool
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -399,7 +1830,7 @@ func (m *IDSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchFees) Unmarshal(dAtA []byte) error {
+func (m *InboundRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -422,15 +1853,15 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: InboundRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InboundRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -458,11 +1889,11 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -490,10 +1921,115 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WindowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeight", wireType)
+			}
+			m.QueuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCancelQueuedDeposit defines the type for a CancelQueuedDepositProposal
+	ProposalTypeCancelQueuedDeposit = "CancelQueuedDeposit"
+)
+
+var _ govtypes.Content = &CancelQueuedDepositProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCancelQueuedDeposit)
+	govtypes.RegisterProposalTypeCodec(&CancelQueuedDepositProposal{}, "gravity/CancelQueuedDepositProposal")
+}
+
+// NewCancelQueuedDepositProposal creates a new cancel queued deposit proposal
+func NewCancelQueuedDepositProposal(title, description string, eventNonce uint64) *CancelQueuedDepositProposal {
+	return &CancelQueuedDepositProposal{Title: title, Description: description, EventNonce: eventNonce}
+}
+
+// GetTitle returns the title of a cancel queued deposit proposal
+func (p *CancelQueuedDepositProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a cancel queued deposit proposal
+func (p *CancelQueuedDepositProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a cancel queued deposit proposal
+func (p *CancelQueuedDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel queued deposit proposal
+func (p *CancelQueuedDepositProposal) ProposalType() string { return ProposalTypeCancelQueuedDeposit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CancelQueuedDepositProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p CancelQueuedDepositProposal) String() string {
+	return fmt.Sprintf(`Cancel Queued Deposit Proposal:
+  Title:       %s
+  Description: %s
+  Event Nonce: %d
+`, p.Title, p.Description, p.EventNonce)
+}
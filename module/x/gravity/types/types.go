@@ -52,17 +52,34 @@ func (b *BridgeValidator) ValidateBasic() error {
 // BridgeValidators is the sorted set of validator data for Ethereum bridge MultiSig set
 type BridgeValidators []*BridgeValidator
 
-// Sort sorts the validators by power
+// Sort sorts the validators in canonical order, by power descending and by Ethereum address
+// where powers are equal. The Gravity.sol contract and the orchestrator expect this order
 func (b BridgeValidators) Sort() {
-	sort.Slice(b, func(i, j int) bool {
-		if b[i].Power == b[j].Power {
-			// Secondary sort on eth address in case powers are equal
-			return EthAddrLessThan(b[i].EthereumAddress, b[j].EthereumAddress)
-		}
+	sort.Slice(b, b.less)
+}
+
+// IsSorted returns true if the validators are in canonical order, see Sort
+func (b BridgeValidators) IsSorted() bool {
+	return sort.SliceIsSorted(b, b.less)
+}
+
+// IsSortedByPower returns true if the validators are in power descending order. Valsets
+// created before the canonical order compared the checksummed addresses of validators with
+// equal power, so only this part of the order holds for all valsets
+func (b BridgeValidators) IsSortedByPower() bool {
+	return sort.SliceIsSorted(b, func(i, j int) bool {
 		return b[i].Power > b[j].Power
 	})
 }
 
+func (b BridgeValidators) less(i, j int) bool {
+	if b[i].Power == b[j].Power {
+		// Secondary sort on eth address in case powers are equal
+		return EthAddrLessThan(b[i].EthereumAddress, b[j].EthereumAddress)
+	}
+	return b[i].Power > b[j].Power
+}
+
 // PowerDiff returns the difference in power between two bridge validator sets
 // note this is Gravity bridge power *not* Cosmos voting power. Cosmos voting
 // power is based on the absolute number of tokens in the staking pool at any given
@@ -78,6 +95,17 @@ func (b BridgeValidators) Sort() {
 // set, after all the validators retained their relative percentages during inflation and normalized Gravity bridge power
 // shows no difference.
 func (b BridgeValidators) PowerDiff(c BridgeValidators) float64 {
+	return math.Abs(float64(b.powerDelta(c)) / float64(math.MaxUint32))
+}
+
+// PowerDiffDec returns the same power difference as PowerDiff as an exact decimal, so that
+// it can be compared to the ValsetPowerChangeThreshold param without rounding
+func (b BridgeValidators) PowerDiffDec(c BridgeValidators) sdk.Dec {
+	return sdk.NewDecFromBigInt(new(big.Int).SetUint64(b.powerDelta(c))).QuoInt64(math.MaxUint32)
+}
+
+// powerDelta returns the sum of the absolute power changes of all validators in b and c
+func (b BridgeValidators) powerDelta(c BridgeValidators) uint64 {
 	powers := map[string]int64{}
 	// loop over b and initialize the map with their powers
 	for _, bv := range b {
@@ -94,13 +122,15 @@ func (b BridgeValidators) PowerDiff(c BridgeValidators) float64 {
 		}
 	}
 
-	var delta float64
+	var delta uint64
 	for _, v := range powers {
 		// NOTE: we care about the absolute value of the changes
-		delta += math.Abs(float64(v))
+		if v < 0 {
+			v = -v
+		}
+		delta += uint64(v)
 	}
-
-	return math.Abs(delta / float64(math.MaxUint32))
+	return delta
 }
 
 // TotalPower returns the total power in the bridge validator set
@@ -120,6 +150,33 @@ func (b BridgeValidators) HasDuplicates() bool {
 	return len(m) != len(b)
 }
 
+// Equal returns true if both sets contain the same members with the same power,
+// regardless of their order. A set that lists a member more than once is never
+// equal to another set.
+func (b BridgeValidators) Equal(c BridgeValidators) bool {
+	if len(b) != len(c) {
+		return false
+	}
+	powers := make(map[string]uint64, len(b))
+	for _, bv := range b {
+		addr := strings.ToLower(bv.EthereumAddress)
+		if _, dup := powers[addr]; dup {
+			return false
+		}
+		powers[addr] = bv.Power
+	}
+	for _, cv := range c {
+		addr := strings.ToLower(cv.EthereumAddress)
+		power, ok := powers[addr]
+		if !ok || power != cv.Power {
+			return false
+		}
+		// remove the match so a duplicate in c can not match it again
+		delete(powers, addr)
+	}
+	return true
+}
+
 // GetPowers returns only the power values for all members
 func (b BridgeValidators) GetPowers() []uint64 {
 	r := make([]uint64, len(b))
@@ -129,9 +186,9 @@ func (b BridgeValidators) GetPowers() []uint64 {
 	return r
 }
 
-// ValidateBasic performs stateless checks
+// ValidateBasic performs stateless checks, the set has to be in power descending order. The
+// order of validators with equal power is not checked, see IsSortedByPower
 func (b BridgeValidators) ValidateBasic() error {
-	// TODO: check if the set is sorted here?
 	if len(b) == 0 {
 		return ErrEmpty
 	}
@@ -143,10 +200,13 @@ func (b BridgeValidators) ValidateBasic() error {
 	if b.HasDuplicates() {
 		return sdkerrors.Wrap(ErrDuplicate, "addresses")
 	}
+	if !b.IsSortedByPower() {
+		return sdkerrors.Wrap(ErrInvalid, "members not sorted by power")
+	}
 	return nil
 }
 
-// NewValset returns a new valset
+// NewValset returns a new valset, with its members in canonical order
 func NewValset(nonce, height uint64, members BridgeValidators) *Valset {
 	members.Sort()
 	var mem []*BridgeValidator
@@ -156,6 +216,17 @@ func NewValset(nonce, height uint64, members BridgeValidators) *Valset {
 	return &Valset{Nonce: uint64(nonce), Members: mem, Height: height}
 }
 
+// ValidateBasic performs stateless checks. The members of a valset are not re-sorted, since
+// the checkpoint signed for it depends on their order, so valsets exported before the
+// canonical order are still valid. A valset without members is stored while no validator
+// has set an Ethereum key, so it is valid
+func (v Valset) ValidateBasic() error {
+	if len(v.Members) == 0 {
+		return nil
+	}
+	return sdkerrors.Wrap(BridgeValidators(v.Members).ValidateBasic(), "members")
+}
+
 // GetCheckpoint returns the checkpoint
 func (v Valset) GetCheckpoint(gravityIDstring string) []byte {
 
@@ -235,7 +306,7 @@ func (v Valsets) Swap(i, j int) {
 func (b OutgoingTxBatch) GetFees() sdk.Int {
 	sum := sdk.ZeroInt()
 	for _, t := range b.Transactions {
-		sum.Add(t.Erc20Fee.Amount)
+		sum = sum.Add(t.Erc20Fee.Amount)
 	}
 	return sum
 }
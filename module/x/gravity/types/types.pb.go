@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -249,40 +250,280 @@ func (m *ERC20ToDenom) GetDenom() string {
 	return ""
 }
 
+// BridgeHijackIncident records an observed validator set update on Ethereum
+// whose members differ from the validator set the Gravity module created at
+// that nonce, or which has a nonce the Gravity module never created. It
+// means that the bridge contract is no longer controlled by the validators
+// of this chain
+type BridgeHijackIncident struct {
+	ValsetNonce         uint64             `protobuf:"varint,1,opt,name=valset_nonce,json=valsetNonce,proto3" json:"valset_nonce,omitempty"`
+	ClaimedMembers      []*BridgeValidator `protobuf:"bytes,2,rep,name=claimed_members,json=claimedMembers,proto3" json:"claimed_members,omitempty"`
+	ExpectedMembers     []*BridgeValidator `protobuf:"bytes,3,rep,name=expected_members,json=expectedMembers,proto3" json:"expected_members,omitempty"`
+	EventNonce          uint64             `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumBlockHeight uint64             `protobuf:"varint,5,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
+	CosmosBlockHeight   uint64             `protobuf:"varint,6,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+}
+
+func (m *BridgeHijackIncident) Reset()         { *m = BridgeHijackIncident{} }
+func (m *BridgeHijackIncident) String() string { return proto.CompactTextString(m) }
+func (*BridgeHijackIncident) ProtoMessage()    {}
+func (*BridgeHijackIncident) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{4}
+}
+func (m *BridgeHijackIncident) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeHijackIncident) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeHijackIncident.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeHijackIncident) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeHijackIncident.Merge(m, src)
+}
+func (m *BridgeHijackIncident) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeHijackIncident) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeHijackIncident.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeHijackIncident proto.InternalMessageInfo
+
+func (m *BridgeHijackIncident) GetValsetNonce() uint64 {
+	if m != nil {
+		return m.ValsetNonce
+	}
+	return 0
+}
+
+func (m *BridgeHijackIncident) GetClaimedMembers() []*BridgeValidator {
+	if m != nil {
+		return m.ClaimedMembers
+	}
+	return nil
+}
+
+func (m *BridgeHijackIncident) GetExpectedMembers() []*BridgeValidator {
+	if m != nil {
+		return m.ExpectedMembers
+	}
+	return nil
+}
+
+func (m *BridgeHijackIncident) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *BridgeHijackIncident) GetEthereumBlockHeight() uint64 {
+	if m != nil {
+		return m.EthereumBlockHeight
+	}
+	return 0
+}
+
+func (m *BridgeHijackIncident) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
+	}
+	return 0
+}
+
+// ObservedValset archives a validator set update observed on Ethereum along
+// with the Ethereum block height it happened at and the Cosmos block height it
+// was observed at. The valset stays active on the Gravity.sol contract until the
+// next observed valset update
+type ObservedValset struct {
+	Valset              Valset `protobuf:"bytes,1,opt,name=valset,proto3" json:"valset"`
+	EthereumBlockHeight uint64 `protobuf:"varint,2,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
+	CosmosBlockHeight   uint64 `protobuf:"varint,3,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+}
+
+func (m *ObservedValset) Reset()         { *m = ObservedValset{} }
+func (m *ObservedValset) String() string { return proto.CompactTextString(m) }
+func (*ObservedValset) ProtoMessage()    {}
+func (*ObservedValset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{5}
+}
+func (m *ObservedValset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservedValset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservedValset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObservedValset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservedValset.Merge(m, src)
+}
+func (m *ObservedValset) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservedValset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservedValset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservedValset proto.InternalMessageInfo
+
+func (m *ObservedValset) GetValset() Valset {
+	if m != nil {
+		return m.Valset
+	}
+	return Valset{}
+}
+
+func (m *ObservedValset) GetEthereumBlockHeight() uint64 {
+	if m != nil {
+		return m.EthereumBlockHeight
+	}
+	return 0
+}
+
+func (m *ObservedValset) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
+	}
+	return 0
+}
+
+// EthKeyRotation records a validator's Ethereum key rotation whose grace period
+// has not ended yet. The previous key stays valid for confirms until a valset
+// including the new key is observed on Ethereum
+type EthKeyRotation struct {
+	Validator          string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	PreviousEthAddress string `protobuf:"bytes,2,opt,name=previous_eth_address,json=previousEthAddress,proto3" json:"previous_eth_address,omitempty"`
+	NewEthAddress      string `protobuf:"bytes,3,opt,name=new_eth_address,json=newEthAddress,proto3" json:"new_eth_address,omitempty"`
+	Height             uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EthKeyRotation) Reset()         { *m = EthKeyRotation{} }
+func (m *EthKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*EthKeyRotation) ProtoMessage()    {}
+func (*EthKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{6}
+}
+func (m *EthKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthKeyRotation.Merge(m, src)
+}
+func (m *EthKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthKeyRotation proto.InternalMessageInfo
+
+func (m *EthKeyRotation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EthKeyRotation) GetPreviousEthAddress() string {
+	if m != nil {
+		return m.PreviousEthAddress
+	}
+	return ""
+}
+
+func (m *EthKeyRotation) GetNewEthAddress() string {
+	if m != nil {
+		return m.NewEthAddress
+	}
+	return ""
+}
+
+func (m *EthKeyRotation) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
 	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
 	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
 	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*BridgeHijackIncident)(nil), "gravity.v1.BridgeHijackIncident")
+	proto.RegisterType((*ObservedValset)(nil), "gravity.v1.ObservedValset")
+	proto.RegisterType((*EthKeyRotation)(nil), "gravity.v1.EthKeyRotation")
 }
 
 func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
 
 var fileDescriptor_163831c23fcc179f = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0x4f, 0x4b, 0xfb, 0x40,
-	0x10, 0xed, 0xb6, 0xbf, 0x5f, 0xa5, 0xab, 0x50, 0x4d, 0xb5, 0x14, 0x84, 0x58, 0x72, 0xaa, 0x07,
-	0x93, 0x36, 0x22, 0x82, 0x37, 0xab, 0x05, 0x0f, 0x82, 0x18, 0xa4, 0x07, 0x2f, 0x25, 0xc9, 0x0e,
-	0x49, 0x30, 0xdb, 0x2d, 0xbb, 0xdb, 0x68, 0x3f, 0x80, 0x77, 0x3f, 0x96, 0xc7, 0x1e, 0x3d, 0x4a,
-	0xfb, 0x45, 0x24, 0xbb, 0x89, 0x7f, 0x8f, 0xef, 0xcd, 0xcc, 0x9b, 0x37, 0x6f, 0x70, 0x3b, 0xe2,
-	0x7e, 0x96, 0xc8, 0x85, 0x93, 0x0d, 0x1c, 0xb9, 0x98, 0x81, 0xb0, 0x67, 0x9c, 0x49, 0x66, 0xe0,
-	0x82, 0xb7, 0xb3, 0x81, 0xe5, 0xe1, 0xe6, 0x90, 0x27, 0x24, 0x82, 0xb1, 0x9f, 0x26, 0xc4, 0x97,
-	0x8c, 0x1b, 0xbb, 0xf8, 0xff, 0x8c, 0x3d, 0x02, 0xef, 0xa0, 0x2e, 0xea, 0xfd, 0xf3, 0x34, 0x30,
-	0x0e, 0xf1, 0x36, 0xc8, 0x18, 0x38, 0xcc, 0xe9, 0xc4, 0x27, 0x84, 0x83, 0x10, 0x9d, 0x6a, 0x17,
-	0xf5, 0x1a, 0x5e, 0xb3, 0xe4, 0xcf, 0x35, 0x6d, 0x51, 0x5c, 0x1f, 0xfb, 0xa9, 0x00, 0x99, 0x4b,
-	0x4d, 0xd9, 0x34, 0x84, 0x52, 0x4a, 0x01, 0xe3, 0x04, 0x6f, 0x50, 0xa0, 0x01, 0xf0, 0x5c, 0xa1,
-	0xd6, 0xdb, 0x74, 0xf7, 0xed, 0x2f, 0x47, 0xf6, 0x2f, 0x3b, 0x5e, 0xd9, 0x6b, 0xb4, 0x71, 0x3d,
-	0x86, 0x24, 0x8a, 0x65, 0xa7, 0xa6, 0xd4, 0x0a, 0x64, 0x3d, 0x23, 0x7c, 0x70, 0xed, 0x0b, 0x79,
-	0x13, 0x08, 0xe0, 0x19, 0x90, 0x51, 0x61, 0x67, 0x98, 0xb2, 0xf0, 0xe1, 0x4a, 0xf5, 0x18, 0x36,
-	0x6e, 0x85, 0x4c, 0x50, 0x26, 0x26, 0x41, 0xce, 0x4e, 0x0a, 0x21, 0x6d, 0x6b, 0x47, 0x97, 0xbe,
-	0xf7, 0xbb, 0x78, 0xef, 0xf3, 0xda, 0x1f, 0x13, 0x55, 0x35, 0xd1, 0x82, 0xbf, 0x3b, 0xac, 0x33,
-	0xbc, 0x35, 0xf2, 0x2e, 0xdc, 0xfe, 0x1d, 0xbb, 0x84, 0x29, 0xa3, 0xf9, 0xf1, 0xc0, 0x43, 0xb7,
-	0xaf, 0xb6, 0x34, 0x3c, 0x0d, 0x72, 0x96, 0xe4, 0xe5, 0x22, 0x3c, 0x0d, 0x86, 0xb7, 0xaf, 0x2b,
-	0x13, 0x2d, 0x57, 0x26, 0x7a, 0x5f, 0x99, 0xe8, 0x65, 0x6d, 0x56, 0x96, 0x6b, 0xb3, 0xf2, 0xb6,
-	0x36, 0x2b, 0xf7, 0xa7, 0x51, 0x22, 0xe3, 0x79, 0x60, 0x87, 0x8c, 0x3a, 0xda, 0xa7, 0x53, 0x84,
-	0x75, 0x14, 0xa8, 0xa4, 0x1c, 0xca, 0xc8, 0x3c, 0x05, 0xe7, 0xa9, 0xe4, 0xf5, 0xaf, 0x83, 0xba,
-	0x7a, 0xf6, 0xf1, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x29, 0xe4, 0xbf, 0x06, 0x02, 0x00,
-	0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0x34, 0xa8, 0x93, 0xd2, 0x94, 0x6d, 0xa8, 0x22, 0x40, 0x4e, 0xf1, 0x01, 0x85,
+	0x03, 0x76, 0x1a, 0x84, 0x90, 0xb8, 0x11, 0x1a, 0x54, 0xc4, 0x97, 0xb0, 0x50, 0x0f, 0x5c, 0x2c,
+	0x7f, 0x8c, 0x6c, 0xd3, 0xd8, 0x1b, 0xd9, 0x1b, 0xa7, 0xf9, 0x01, 0xdc, 0xf9, 0x09, 0x9c, 0xf8,
+	0x2d, 0x3d, 0x70, 0xe8, 0x91, 0x13, 0x42, 0xc9, 0x1f, 0x41, 0xde, 0x5d, 0x13, 0xb7, 0xc8, 0x42,
+	0x70, 0xf3, 0xbc, 0x7d, 0xfb, 0xfc, 0xe6, 0x79, 0xc6, 0xb0, 0xef, 0x27, 0x76, 0x16, 0xb2, 0x85,
+	0x91, 0x1d, 0x1a, 0x6c, 0x31, 0xc5, 0x54, 0x9f, 0x26, 0x94, 0x51, 0x02, 0x12, 0xd7, 0xb3, 0xc3,
+	0x5b, 0x1d, 0x9f, 0xfa, 0x94, 0xc3, 0x46, 0xfe, 0x24, 0x18, 0x9a, 0x09, 0xed, 0x51, 0x12, 0x7a,
+	0x3e, 0x9e, 0xd8, 0x93, 0xd0, 0xb3, 0x19, 0x4d, 0x48, 0x07, 0x36, 0xa7, 0x74, 0x8e, 0x49, 0x57,
+	0x39, 0x50, 0xfa, 0x0d, 0x53, 0x14, 0xe4, 0x3e, 0xec, 0x22, 0x0b, 0x30, 0xc1, 0x59, 0x64, 0xd9,
+	0x9e, 0x97, 0x60, 0x9a, 0x76, 0x37, 0x0e, 0x94, 0xfe, 0x96, 0xd9, 0x2e, 0xf0, 0xa7, 0x02, 0xd6,
+	0x22, 0x68, 0x9e, 0xd8, 0x93, 0x14, 0x59, 0x2e, 0x15, 0xd3, 0xd8, 0xc5, 0x42, 0x8a, 0x17, 0xe4,
+	0x11, 0x5c, 0x8b, 0x30, 0x72, 0x30, 0xc9, 0x15, 0xea, 0xfd, 0xd6, 0xf0, 0xb6, 0xbe, 0xf6, 0xa9,
+	0x5f, 0xb1, 0x63, 0x16, 0x5c, 0xb2, 0x0f, 0xcd, 0x00, 0x43, 0x3f, 0x60, 0xdd, 0x3a, 0x57, 0x93,
+	0x95, 0xf6, 0x49, 0x81, 0xde, 0x2b, 0x3b, 0x65, 0x6f, 0x9d, 0x14, 0x93, 0x0c, 0xbd, 0xb1, 0xb4,
+	0x33, 0x9a, 0x50, 0xf7, 0xf4, 0x98, 0x73, 0x88, 0x0e, 0x7b, 0x2e, 0x4d, 0x23, 0x9a, 0x5a, 0x4e,
+	0x8e, 0x5a, 0x52, 0x48, 0xd8, 0xba, 0x21, 0x8e, 0xca, 0xfc, 0x21, 0xdc, 0xfc, 0xdd, 0xed, 0xa5,
+	0x1b, 0x1b, 0xfc, 0xc6, 0x1e, 0xfe, 0xf9, 0x0e, 0xed, 0x09, 0x6c, 0x8f, 0xcd, 0x67, 0xc3, 0xc1,
+	0x7b, 0x7a, 0x84, 0x31, 0x8d, 0xf2, 0xe6, 0x31, 0x71, 0x87, 0x03, 0xfe, 0x96, 0x2d, 0x53, 0x14,
+	0x39, 0xea, 0xe5, 0xc7, 0x32, 0x3c, 0x51, 0x68, 0xdf, 0x36, 0xa0, 0x23, 0x1a, 0x3f, 0x0e, 0x3f,
+	0xda, 0xee, 0xe9, 0x8b, 0xd8, 0x0d, 0x3d, 0x8c, 0x19, 0xb9, 0x0b, 0xdb, 0x19, 0xcf, 0xd2, 0x2a,
+	0x07, 0xd9, 0x12, 0xd8, 0x1b, 0x1e, 0xe7, 0x11, 0xb4, 0xdd, 0x89, 0x1d, 0x46, 0xe8, 0x59, 0xff,
+	0x10, 0xeb, 0x8e, 0xbc, 0xf3, 0x5a, 0xa6, 0xfb, 0x1c, 0x76, 0xf1, 0x6c, 0x8a, 0x2e, 0x2b, 0xc9,
+	0xd4, 0xff, 0x2e, 0xd3, 0x2e, 0x2e, 0x15, 0x3a, 0x3d, 0x68, 0x61, 0x86, 0x71, 0xe1, 0xb7, 0xc1,
+	0xfd, 0x02, 0x87, 0x84, 0xdd, 0xca, 0x68, 0x37, 0x2b, 0xa3, 0xad, 0xfa, 0x7c, 0xcd, 0x8a, 0xcf,
+	0xa7, 0x7d, 0x55, 0x60, 0xa7, 0x18, 0x07, 0x39, 0x8a, 0x03, 0x68, 0x8a, 0xd0, 0x78, 0x84, 0xad,
+	0x21, 0x29, 0x77, 0x25, 0x38, 0xa3, 0xc6, 0xf9, 0x8f, 0x5e, 0xcd, 0x94, 0xbc, 0xff, 0x99, 0x81,
+	0x2a, 0xa3, 0xf5, 0x2a, 0xa3, 0x5f, 0x14, 0xd8, 0x19, 0xb3, 0xe0, 0x25, 0x2e, 0x4c, 0xca, 0x6c,
+	0x16, 0xd2, 0x98, 0xdc, 0x81, 0xad, 0xac, 0x88, 0x57, 0x8e, 0xce, 0x1a, 0x20, 0x03, 0xe8, 0x4c,
+	0x13, 0xcc, 0x42, 0x3a, 0x4b, 0x2d, 0x64, 0xc1, 0x95, 0x55, 0x24, 0xc5, 0xd9, 0x98, 0x05, 0x72,
+	0x1b, 0xc9, 0x3d, 0x68, 0xc7, 0x38, 0xbf, 0x44, 0xae, 0x73, 0xf2, 0xf5, 0x18, 0xe7, 0x25, 0xde,
+	0x7a, 0xbd, 0x1a, 0xe5, 0xf5, 0x1a, 0xbd, 0x3b, 0x5f, 0xaa, 0xca, 0xc5, 0x52, 0x55, 0x7e, 0x2e,
+	0x55, 0xe5, 0xf3, 0x4a, 0xad, 0x5d, 0xac, 0xd4, 0xda, 0xf7, 0x95, 0x5a, 0xfb, 0xf0, 0xd8, 0x0f,
+	0x59, 0x30, 0x73, 0x74, 0x97, 0x46, 0x86, 0x68, 0xcd, 0x90, 0x99, 0x3e, 0x70, 0xf8, 0x98, 0x18,
+	0x11, 0xf5, 0x66, 0x13, 0x34, 0xce, 0x0a, 0x5c, 0xfc, 0x9c, 0x9c, 0x26, 0xff, 0xf7, 0x3c, 0xfc,
+	0x35, 0x00, 0xca, 0x18, 0x58, 0xcc, 0xb7, 0x04, 0x00, 0x00,
 }
 
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
@@ -437,6 +678,169 @@ func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BridgeHijackIncident) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeHijackIncident) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeHijackIncident) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExpectedMembers) > 0 {
+		for iNdEx := len(m.ExpectedMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpectedMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ClaimedMembers) > 0 {
+		for iNdEx := len(m.ClaimedMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ValsetNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValsetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservedValset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservedValset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservedValset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EthKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewEthAddress) > 0 {
+		i -= len(m.NewEthAddress)
+		copy(dAtA[i:], m.NewEthAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewEthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousEthAddress) > 0 {
+		i -= len(m.PreviousEthAddress)
+		copy(dAtA[i:], m.PreviousEthAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PreviousEthAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -479,51 +883,445 @@ func (m *Valset) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	return n
-}
-
-func (m *LastObservedEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CosmosBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
-	}
-	if m.EthereumBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
-	}
-	return n
-}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeHijackIncident) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValsetNonce != 0 {
+		n += 1 + sovTypes(uint64(m.ValsetNonce))
+	}
+	if len(m.ClaimedMembers) > 0 {
+		for _, e := range m.ClaimedMembers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ExpectedMembers) > 0 {
+		for _, e := range m.ExpectedMembers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	return n
+}
+
+func (m *ObservedValset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Valset.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	return n
+}
+
+func (m *EthKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PreviousEthAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewEthAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Valset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -546,17 +1344,17 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -566,14 +1364,27 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -601,7 +1412,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -627,7 +1438,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Valset) Unmarshal(dAtA []byte) error {
+func (m *BridgeHijackIncident) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -650,17 +1461,17 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeHijackIncident: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeHijackIncident: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
 			}
-			m.Nonce = 0
+			m.ValsetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -670,14 +1481,14 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.ValsetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedMembers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -704,16 +1515,50 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimedMembers = append(m.ClaimedMembers, &BridgeValidator{})
+			if err := m.ClaimedMembers[len(m.ClaimedMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedMembers = append(m.ExpectedMembers, &BridgeValidator{})
+			if err := m.ExpectedMembers[len(m.ExpectedMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.Height = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -723,7 +1568,45 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -752,7 +1635,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *ObservedValset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -775,17 +1658,17 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObservedValset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObservedValset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -795,11 +1678,25 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
@@ -819,6 +1716,25 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -843,7 +1759,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *EthKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -866,15 +1782,15 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -902,11 +1818,11 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEthAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -934,8 +1850,59 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.PreviousEthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
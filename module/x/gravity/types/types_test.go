@@ -3,11 +3,15 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	mrand "math/rand"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValsetConfirmHash(t *testing.T) {
@@ -114,6 +118,48 @@ func TestValsetPowerDiff(t *testing.T) {
 	}
 }
 
+func TestValsetPowerDiffDec(t *testing.T) {
+	start := BridgeValidators{
+		{Power: 2147483648, EthereumAddress: "0x479FFc856Cdfa0f5D1AE6Fa61915b01351A7773D"},
+		{Power: 2147483647, EthereumAddress: "0x8E91960d704Df3fF24ECAb78AB9df1B5D9144140"},
+	}
+	diff := BridgeValidators{
+		{Power: 1932735283, EthereumAddress: "0x479FFc856Cdfa0f5D1AE6Fa61915b01351A7773D"},
+		{Power: 2147483647, EthereumAddress: "0x8E91960d704Df3fF24ECAb78AB9df1B5D9144140"},
+		{Power: 214748365, EthereumAddress: "0xF14879a175A2F1cEFC7c616f35b6d9c2b0Fd8326"},
+	}
+	// 429496730 / 4294967295 is just above 0.1
+	got := start.PowerDiffDec(diff)
+	assert.Equal(t, sdk.NewDec(429496730).QuoInt64(math.MaxUint32), got)
+	assert.True(t, got.GT(sdk.NewDecWithPrec(1, 1)))
+	assert.True(t, start.PowerDiffDec(start).IsZero())
+}
+
+func TestBridgeValidatorsEqual(t *testing.T) {
+	a := &BridgeValidator{Power: 1, EthereumAddress: "0x479FFc856Cdfa0f5D1AE6Fa61915b01351A7773D"}
+	b := &BridgeValidator{Power: 1, EthereumAddress: "0x8E91960d704Df3fF24ECAb78AB9df1B5D9144140"}
+	lowerA := &BridgeValidator{Power: 1, EthereumAddress: strings.ToLower(a.EthereumAddress)}
+	specs := map[string]struct {
+		x, y BridgeValidators
+		exp  bool
+	}{
+		"same order":         {x: BridgeValidators{a, b}, y: BridgeValidators{a, b}, exp: true},
+		"other order":        {x: BridgeValidators{a, b}, y: BridgeValidators{b, a}, exp: true},
+		"address case":       {x: BridgeValidators{a, b}, y: BridgeValidators{lowerA, b}, exp: true},
+		"different power":    {x: BridgeValidators{a, b}, y: BridgeValidators{a, &BridgeValidator{Power: 2, EthereumAddress: b.EthereumAddress}}, exp: false},
+		"different length":   {x: BridgeValidators{a, b}, y: BridgeValidators{a}, exp: false},
+		"duplicate in left":  {x: BridgeValidators{a, a}, y: BridgeValidators{a, b}, exp: false},
+		"duplicate in right": {x: BridgeValidators{a, b}, y: BridgeValidators{a, a}, exp: false},
+		"duplicate by case":  {x: BridgeValidators{a, lowerA}, y: BridgeValidators{a, b}, exp: false},
+		"both duplicated":    {x: BridgeValidators{a, a}, y: BridgeValidators{a, a}, exp: false},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.x.Equal(spec.y))
+		})
+	}
+}
+
 func TestValsetSort(t *testing.T) {
 	specs := map[string]struct {
 		src BridgeValidators
@@ -143,6 +189,18 @@ func TestValsetSort(t *testing.T) {
 				{Power: 1, EthereumAddress: gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(3)}, 20)).String()},
 			},
 		},
+		"by eth addr bytes regardless of case": {
+			src: BridgeValidators{
+				{Power: 1, EthereumAddress: "0xC000000000000000000000000000000000000000"},
+				{Power: 1, EthereumAddress: "0xb000000000000000000000000000000000000000"},
+				{Power: 1, EthereumAddress: "0xBa00000000000000000000000000000000000000"},
+			},
+			exp: BridgeValidators{
+				{Power: 1, EthereumAddress: "0xb000000000000000000000000000000000000000"},
+				{Power: 1, EthereumAddress: "0xBa00000000000000000000000000000000000000"},
+				{Power: 1, EthereumAddress: "0xC000000000000000000000000000000000000000"},
+			},
+		},
 		// if you're thinking about changing this due to a change in the sorting algorithm
 		// you MUST go change this in gravity_utils/types.rs as well. You will also break all
 		// bridges in production when they try to migrate so use extreme caution!
@@ -173,6 +231,7 @@ func TestValsetSort(t *testing.T) {
 		t.Run(msg, func(t *testing.T) {
 			spec.src.Sort()
 			assert.Equal(t, spec.src, spec.exp)
+			assert.True(t, spec.src.IsSorted())
 			shuffled := shuffled(spec.src)
 			shuffled.Sort()
 			assert.Equal(t, shuffled, spec.exp)
@@ -180,9 +239,56 @@ func TestValsetSort(t *testing.T) {
 	}
 }
 
+func TestValsetCheckpointEqualPowers(t *testing.T) {
+	members := func() BridgeValidators {
+		return BridgeValidators{
+			{Power: 1431655765, EthereumAddress: "0xc783df8a850f42e7F7e57013759C285caa701eB6"},
+			{Power: 1431655765, EthereumAddress: "0xeAD9C93b79Ae7C1591b1FB5323BD777E86e150d4"},
+			{Power: 1431655765, EthereumAddress: "0xE5904695748fe4A84b40b3fc79De2277660BD1D3"},
+		}
+	}
+	canonical := NewValset(1, 1, members())
+	require.NoError(t, canonical.ValidateBasic())
+	// the checksum casing of the addresses doesn't decide the order
+	assert.Equal(t, "0xE5904695748fe4A84b40b3fc79De2277660BD1D3", canonical.Members[1].EthereumAddress)
+
+	// the members passed in any order produce the same checkpoint
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, canonical.GetCheckpoint("foo"), NewValset(1, 1, shuffled(members())).GetCheckpoint("foo"))
+	}
+
+	// swapping two members of equal power changes the checkpoint, such a valset isn't
+	// canonical but is still valid, since valsets signed before the canonical order kept it
+	swapped := Valset{Nonce: 1, Members: members()}
+	assert.NotEqual(t, canonical.GetCheckpoint("foo"), swapped.GetCheckpoint("foo"))
+	assert.False(t, BridgeValidators(swapped.Members).IsSorted())
+	require.NoError(t, swapped.ValidateBasic())
+
+	// higher powers come first, whatever the addresses
+	unsorted := Valset{Nonce: 1, Members: []*BridgeValidator{
+		{Power: 1, EthereumAddress: "0x0000000000000000000000000000000000000001"},
+		{Power: 2, EthereumAddress: "0x0000000000000000000000000000000000000002"},
+	}}
+	require.Error(t, unsorted.ValidateBasic())
+	assert.NoError(t, Valset{Nonce: 1}.ValidateBasic())
+}
+
 func shuffled(v BridgeValidators) BridgeValidators {
 	mrand.Shuffle(len(v), func(i, j int) {
 		v[i], v[j] = v[j], v[i]
 	})
 	return v
 }
+
+func TestOutgoingTxBatchGetFees(t *testing.T) {
+	const tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	batch := OutgoingTxBatch{
+		Transactions: []*OutgoingTransferTx{
+			{Id: 1, Erc20Fee: NewERC20Token(2, tokenContract)},
+			{Id: 2, Erc20Fee: NewERC20Token(3, tokenContract)},
+		},
+		TokenContract: tokenContract,
+	}
+	assert.Equal(t, "5", batch.GetFees().String())
+	assert.True(t, OutgoingTxBatch{}.GetFees().IsZero())
+}
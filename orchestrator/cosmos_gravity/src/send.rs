@@ -9,9 +9,15 @@ use deep_space::Msg;
 use deep_space::{coin::Coin, utils::bytes_to_hex_str};
 use ethereum_gravity::utils::downcast_uint256;
 use gravity_proto::cosmos_sdk_proto::cosmos::base::abci::v1beta1::TxResponse;
+use gravity_proto::cosmos_sdk_proto::cosmos::crypto::secp256k1::PubKey;
+use gravity_proto::cosmos_sdk_proto::cosmos::tx::signing::v1beta1::SignMode;
 use gravity_proto::cosmos_sdk_proto::cosmos::tx::v1beta1::service_client::ServiceClient as TxServiceClient;
 use gravity_proto::cosmos_sdk_proto::cosmos::tx::v1beta1::BroadcastMode;
 use gravity_proto::cosmos_sdk_proto::cosmos::tx::v1beta1::BroadcastTxRequest;
+use gravity_proto::cosmos_sdk_proto::cosmos::tx::v1beta1::{
+    mode_info, AuthInfo, ModeInfo, SignDoc, SignerInfo, TxBody, TxRaw,
+};
+use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
 use gravity_proto::gravity::MsgConfirmBatch;
 use gravity_proto::gravity::MsgConfirmLogicCall;
 use gravity_proto::gravity::MsgDepositClaim;
@@ -23,21 +29,29 @@ use gravity_proto::gravity::MsgSetOrchestratorAddress;
 use gravity_proto::gravity::MsgValsetConfirm;
 use gravity_proto::gravity::MsgValsetUpdatedClaim;
 use gravity_proto::gravity::MsgWithdrawClaim;
+use gravity_proto::gravity::QueryDelegateKeysNonceRequest;
+use gravity_proto::gravity::QueryParamsRequest;
 use gravity_utils::message_signatures::{
-    encode_logic_call_confirm, encode_tx_batch_confirm, encode_valset_confirm,
+    encode_delegate_keys, encode_logic_call_confirm, encode_tx_batch_confirm, encode_valset_confirm,
 };
 use gravity_utils::types::*;
+use prost::Message;
+use prost_types::Any;
+use secp256k1::{Message as Secp256k1Message, Secp256k1, SecretKey};
+use sha2::{Digest, Sha256};
 use std::{collections::HashMap, time::Duration};
 
 pub const MEMO: &str = "Sent using Althea Orchestrator";
 pub const TIMEOUT: Duration = Duration::from_secs(60);
 
-/// Send a transaction updating the eth address for the sending
-/// Cosmos address. The sending Cosmos address should be a validator
+/// Send a transaction updating the eth address and orchestrator for the sending
+/// Cosmos address. The sending Cosmos address should be a validator, the Ethereum
+/// key signs over the gravity id and delegate keys nonce and the orchestrator key
+/// co-signs the transaction to consent to being delegated to
 pub async fn update_gravity_delegate_addresses(
     contact: &Contact,
-    delegate_eth_address: EthAddress,
-    delegate_cosmos_address: Address,
+    delegate_eth_private_key: EthPrivateKey,
+    delegate_private_key: PrivateKey,
     private_key: PrivateKey,
     fee: Coin,
 ) -> Result<TxResponse, CosmosGrpcError> {
@@ -52,11 +66,40 @@ pub async fn update_gravity_delegate_addresses(
         .to_bech32(format!("{}valoper", contact.get_prefix()))
         .unwrap();
     let our_address = private_key.to_address(&contact.get_prefix()).unwrap();
+    let delegate_cosmos_address = delegate_private_key
+        .to_address(&contact.get_prefix())
+        .unwrap();
+    let delegate_eth_address = delegate_eth_private_key.to_public_key().unwrap();
+
+    let mut grpc = GravityQueryClient::connect(contact.get_url()).await?;
+    let gravity_id = grpc
+        .params(QueryParamsRequest {})
+        .await?
+        .into_inner()
+        .params
+        .unwrap()
+        .gravity_id;
+    let nonce = grpc
+        .delegate_keys_nonce(QueryDelegateKeysNonceRequest {
+            validator_address: our_valoper_address.to_string(),
+        })
+        .await?
+        .into_inner()
+        .nonce;
+    let message = encode_delegate_keys(
+        gravity_id,
+        our_address.as_bytes(),
+        delegate_cosmos_address.as_bytes(),
+        nonce,
+    );
+    let eth_signature = delegate_eth_private_key.sign_ethereum_msg(&message);
 
     let msg_set_orch_address = MsgSetOrchestratorAddress {
         validator: our_valoper_address.to_string(),
         orchestrator: delegate_cosmos_address.to_string(),
         eth_address: delegate_eth_address.to_string(),
+        nonce,
+        eth_signature: bytes_to_hex_str(&eth_signature.to_bytes()),
     };
 
     let fee = Fee {
@@ -66,20 +109,26 @@ pub async fn update_gravity_delegate_addresses(
         payer: None,
     };
 
-    let msg = Msg::new(
-        "/gravity.v1.MsgSetOrchestratorAddress",
-        msg_set_orch_address,
-    );
-
-    let args = contact.get_message_args(our_address, fee).await?;
-    trace!("got optional tx info");
+    let mut msg_bytes = Vec::new();
+    msg_set_orch_address.encode(&mut msg_bytes).unwrap();
+    let msg = Any {
+        type_url: "/gravity.v1.MsgSetOrchestratorAddress".to_string(),
+        value: msg_bytes,
+    };
 
-    let msg_bytes = private_key.sign_std_msg(&[msg], args, MEMO)?;
+    // the validator pays the fee, the orchestrator only co-signs
+    let tx_bytes = sign_multi_signer_tx(
+        contact,
+        vec![msg],
+        &[private_key, delegate_private_key],
+        fee,
+    )
+    .await?;
 
     let mut txrpc = TxServiceClient::connect(contact.get_url()).await?;
     let response = txrpc
         .broadcast_tx(BroadcastTxRequest {
-            tx_bytes: msg_bytes,
+            tx_bytes,
             mode: BroadcastMode::Sync.into(),
         })
         .await?;
@@ -90,6 +139,86 @@ pub async fn update_gravity_delegate_addresses(
         .await
 }
 
+/// Signs a transaction with every one of the given keys, the first one paying the fee.
+/// Needed for messages with more than one signer, deep_space only signs with a single key
+async fn sign_multi_signer_tx(
+    contact: &Contact,
+    messages: Vec<Any>,
+    private_keys: &[PrivateKey],
+    fee: Fee,
+) -> Result<Vec<u8>, CosmosGrpcError> {
+    let mut signer_infos = Vec::new();
+    let mut signer_args = Vec::new();
+    for private_key in private_keys {
+        let address = private_key.to_address(&contact.get_prefix()).unwrap();
+        let args = contact.get_message_args(address, fee.clone()).await?;
+
+        let public_key = PubKey {
+            key: private_key.to_public_key(&contact.get_prefix())?.to_vec(),
+        };
+        let mut public_key_bytes = Vec::new();
+        public_key.encode(&mut public_key_bytes).unwrap();
+        signer_infos.push(SignerInfo {
+            public_key: Some(Any {
+                type_url: "/cosmos.crypto.secp256k1.PubKey".to_string(),
+                value: public_key_bytes,
+            }),
+            mode_info: Some(ModeInfo {
+                sum: Some(mode_info::Sum::Single(mode_info::Single {
+                    mode: SignMode::Direct as i32,
+                })),
+            }),
+            sequence: args.sequence,
+        });
+        signer_args.push(args);
+    }
+
+    let body = TxBody {
+        messages,
+        memo: MEMO.to_string(),
+        timeout_height: signer_args[0].timeout_height,
+        extension_options: Vec::new(),
+        non_critical_extension_options: Vec::new(),
+    };
+    let mut body_bytes = Vec::new();
+    body.encode(&mut body_bytes).unwrap();
+    let auth_info = AuthInfo {
+        signer_infos,
+        fee: Some(fee.into()),
+    };
+    let mut auth_info_bytes = Vec::new();
+    auth_info.encode(&mut auth_info_bytes).unwrap();
+
+    // every signer signs the same body and auth info with its own account number
+    let secp256k1 = Secp256k1::new();
+    let mut signatures = Vec::new();
+    for (private_key, args) in private_keys.iter().zip(signer_args) {
+        let sign_doc = SignDoc {
+            body_bytes: body_bytes.clone(),
+            auth_info_bytes: auth_info_bytes.clone(),
+            chain_id: args.chain_id.to_string(),
+            account_number: args.account_number,
+        };
+        let mut sign_doc_bytes = Vec::new();
+        sign_doc.encode(&mut sign_doc_bytes).unwrap();
+
+        let secret_key = SecretKey::from_slice(&private_key.to_bytes()).unwrap();
+        let digest = Sha256::digest(&sign_doc_bytes);
+        let signature =
+            secp256k1.sign(&Secp256k1Message::from_slice(&digest).unwrap(), &secret_key);
+        signatures.push(signature.serialize_compact().to_vec());
+    }
+
+    let tx_raw = TxRaw {
+        body_bytes,
+        auth_info_bytes,
+        signatures,
+    };
+    let mut tx_bytes = Vec::new();
+    tx_raw.encode(&mut tx_bytes).unwrap();
+    Ok(tx_bytes)
+}
+
 /// Send in a confirmation for an array of validator sets, it's far more efficient to send these
 /// as a single message
 #[allow(clippy::too_many_arguments)]
@@ -470,6 +599,8 @@ pub async fn send_request_batch(
     let msg_request_batch = MsgRequestBatch {
         sender: our_address.to_string(),
         denom,
+        strict: false,
+        max_batch_size: 0,
     };
 
     let fee = Fee {
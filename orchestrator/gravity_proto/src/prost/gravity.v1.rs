@@ -1,26 +1,20 @@
 /// Attestation is an aggregate of `claims` that eventually becomes `observed` by
 /// all orchestrators
-/// EVENT_NONCE:
-/// EventNonce a nonce provided by the gravity contract that is unique per event fired
-/// These event nonces must be relayed in order. This is a correctness issue,
-/// if relaying out of order transaction replay attacks become possible
-/// OBSERVED:
-/// Observed indicates that >67% of validators have attested to the event,
-/// and that the event should be executed by the gravity state machine
-///
-/// The actual content of the claims is passed in with the transaction making the claim
-/// and then passed through the call stack alongside the attestation while it is processed
-/// the key in which the attestation is stored is keyed on the exact details of the claim
-/// but there is no reason to store those exact details becuause the next message sender
-/// will kindly provide you with them.
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Attestation {
+    /// This field stores whether the Attestation has had its event applied to the Cosmos state. This happens when
+    /// enough (usually >2/3s) of the validator power votes that they saw the event on Ethereum.
+    /// For example, once a DepositClaim has modified the token balance of the account that it was deposited to,
+    /// this boolean will be set to true.
     #[prost(bool, tag="1")]
     pub observed: bool,
+    /// This is an array of the addresses of the validators which have voted that they saw the event on Ethereum.
     #[prost(string, repeated, tag="2")]
     pub votes: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// This is the Cosmos block height that this event was first observed by a validator.
     #[prost(uint64, tag="3")]
     pub height: u64,
+    /// The claim is the Ethereum event that this attestation is recording votes for.
     #[prost(message, optional, tag="4")]
     pub claim: ::core::option::Option<::prost_types::Any>,
 }
@@ -36,8 +30,9 @@ pub struct Erc20Token {
     #[prost(string, tag="2")]
     pub amount: ::prost::alloc::string::String,
 }
-/// ClaimType is the cosmos type of an event from the counterpart chain that can
-/// be handled
+// ClaimType is the cosmos type of an event from the counterpart chain that can
+// be handled
+
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum ClaimType {
@@ -48,19 +43,6 @@ pub enum ClaimType {
     LogicCallExecuted = 4,
     ValsetUpdated = 5,
 }
-/// IDSet represents a set of IDs
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct IdSet {
-    #[prost(uint64, repeated, tag="1")]
-    pub ids: ::prost::alloc::vec::Vec<u64>,
-}
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct BatchFees {
-    #[prost(string, tag="1")]
-    pub token: ::prost::alloc::string::String,
-    #[prost(string, tag="2")]
-    pub total_fees: ::prost::alloc::string::String,
-}
 /// OutgoingTxBatch represents a batch of transactions going from gravity to ETH
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct OutgoingTxBatch {
@@ -75,7 +57,8 @@ pub struct OutgoingTxBatch {
     #[prost(uint64, tag="5")]
     pub block: u64,
 }
-/// OutgoingTransferTx represents an individual send from gravity to ETH
+/// OutgoingTransferTx represents an individual send from gravity to ETH, BLOCK
+/// is the Cosmos block height at which it was added to the pool
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct OutgoingTransferTx {
     #[prost(uint64, tag="1")]
@@ -88,6 +71,8 @@ pub struct OutgoingTransferTx {
     pub erc20_token: ::core::option::Option<Erc20Token>,
     #[prost(message, optional, tag="5")]
     pub erc20_fee: ::core::option::Option<Erc20Token>,
+    #[prost(uint64, tag="6")]
+    pub block: u64,
 }
 /// OutgoingLogicCall represents an individual logic call from gravity to ETH
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -106,6 +91,8 @@ pub struct OutgoingLogicCall {
     pub invalidation_id: ::prost::alloc::vec::Vec<u8>,
     #[prost(uint64, tag="7")]
     pub invalidation_nonce: u64,
+    #[prost(uint64, tag="8")]
+    pub block: u64,
 }
 /// SignType defines messages that have been signed by an orchestrator
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
@@ -156,6 +143,53 @@ pub struct Erc20ToDenom {
     #[prost(string, tag="2")]
     pub denom: ::prost::alloc::string::String,
 }
+/// BridgeHijackIncident records an observed validator set update on Ethereum
+/// whose members differ from the validator set the Gravity module created at
+/// that nonce, or which has a nonce the Gravity module never created. It
+/// means that the bridge contract is no longer controlled by the validators
+/// of this chain
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeHijackIncident {
+    #[prost(uint64, tag="1")]
+    pub valset_nonce: u64,
+    #[prost(message, repeated, tag="2")]
+    pub claimed_members: ::prost::alloc::vec::Vec<BridgeValidator>,
+    #[prost(message, repeated, tag="3")]
+    pub expected_members: ::prost::alloc::vec::Vec<BridgeValidator>,
+    #[prost(uint64, tag="4")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag="5")]
+    pub ethereum_block_height: u64,
+    #[prost(uint64, tag="6")]
+    pub cosmos_block_height: u64,
+}
+/// ObservedValset archives a validator set update observed on Ethereum along
+/// with the Ethereum block height it happened at and the Cosmos block height it
+/// was observed at. The valset stays active on the Gravity.sol contract until the
+/// next observed valset update
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ObservedValset {
+    #[prost(message, optional, tag="1")]
+    pub valset: ::core::option::Option<Valset>,
+    #[prost(uint64, tag="2")]
+    pub ethereum_block_height: u64,
+    #[prost(uint64, tag="3")]
+    pub cosmos_block_height: u64,
+}
+/// EthKeyRotation records a validator's Ethereum key rotation whose grace period
+/// has not ended yet. The previous key stays valid for confirms until a valset
+/// including the new key is observed on Ethereum
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthKeyRotation {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub previous_eth_address: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub new_eth_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub height: u64,
+}
 /// MsgSetOrchestratorAddress
 /// this message allows validators to delegate their voting responsibilities
 /// to a given key. This key is then used as an optional authentication method
@@ -165,10 +199,20 @@ pub struct Erc20ToDenom {
 /// that references a validator in the active set
 /// ORCHESTRATOR
 /// The orchestrator field is a cosmos1... string  (i.e. sdk.AccAddress) that
-/// references the key that is being delegated to
+/// references the key that is being delegated to, it replaces all orchestrator
+/// keys of the validator. In genesis state there is an entry for every
+/// orchestrator key of a validator
 /// ETH_ADDRESS
 /// This is a hex encoded 0x Ethereum public key that will be used by this validator
 /// on Ethereum
+/// NONCE
+/// The delegate keys nonce of the validator, it starts at 0 and is incremented
+/// every time the validator sets its delegate keys. In genesis state it is the
+/// nonce the next MsgSetOrchestratorAddress of the validator has to use
+/// ETH_SIGNATURE
+/// This is a hex encoded signature of the Ethereum key over the validator,
+/// orchestrator and nonce, proving that the validator holds the key. The
+/// orchestrator proves that it consents by co-signing the transaction
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddress {
     #[prost(string, tag="1")]
@@ -177,10 +221,54 @@ pub struct MsgSetOrchestratorAddress {
     pub orchestrator: ::prost::alloc::string::String,
     #[prost(string, tag="3")]
     pub eth_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub nonce: u64,
+    #[prost(string, tag="5")]
+    pub eth_signature: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddressResponse {
 }
+/// MsgAddOrchestratorAddress
+/// this message allows validators to delegate to an additional orchestrator key,
+/// for instance one run as a hot standby. Claims and confirms of all the
+/// orchestrator keys of a validator count once for the validator. The number of
+/// keys is bounded by the max_orchestrators_per_validator parameter
+/// VALIDATOR
+/// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+/// that already set its delegate keys with MsgSetOrchestratorAddress
+/// ORCHESTRATOR
+/// The orchestrator field is a cosmos1... string (i.e. sdk.AccAddress) that
+/// references the key that is being delegated to, it co-signs the transaction
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgAddOrchestratorAddress {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub orchestrator: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgAddOrchestratorAddressResponse {
+}
+/// MsgRemoveOrchestratorAddress
+/// this message allows validators to revoke one of their orchestrator keys,
+/// the last orchestrator key of a validator can't be removed, it can only be
+/// replaced with MsgSetOrchestratorAddress
+/// VALIDATOR
+/// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+/// ORCHESTRATOR
+/// The orchestrator field is a cosmos1... string (i.e. sdk.AccAddress) of the
+/// key that is revoked
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRemoveOrchestratorAddress {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub orchestrator: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRemoveOrchestratorAddressResponse {
+}
 /// MsgValsetConfirm
 /// this is the message sent by the validators when they wish to submit their
 /// signatures over the validator set at a given block height. A validator must
@@ -243,7 +331,10 @@ pub struct MsgSendToEthResponse {
 /// looks at the AddToOutgoingPool tx's in the store and generates a batch, also
 /// available in the store tied to this message. The validators then grab this
 /// batch, sign it, submit the signatures with a MsgConfirmBatch before a relayer
-/// can finally submit the batch
+/// can finally submit the batch. A batch that would fall below the batch
+/// threshold of its token is not created, STRICT makes the message fail instead.
+/// MAX_BATCH_SIZE optionally limits the batch to fewer transactions than the
+/// batch size of its token, 0 uses the batch size of the token
 /// -------------
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgRequestBatch {
@@ -251,9 +342,16 @@ pub struct MsgRequestBatch {
     pub sender: ::prost::alloc::string::String,
     #[prost(string, tag="2")]
     pub denom: ::prost::alloc::string::String,
+    #[prost(bool, tag="3")]
+    pub strict: bool,
+    #[prost(uint64, tag="4")]
+    pub max_batch_size: u64,
 }
+/// BATCH_NONCE is the nonce of the created batch, 0 if no batch was created
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgRequestBatchResponse {
+    #[prost(uint64, tag="1")]
+    pub batch_nonce: u64,
 }
 /// MsgConfirmBatch
 /// When validators observe a MsgRequestBatch they form a batch by ordering
@@ -420,7 +518,156 @@ pub struct MsgCancelSendToEth {
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgCancelSendToEthResponse {
 }
-# [doc = r" Generated client implementations."] pub mod msg_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Msg defines the state transitions possible within gravity"] pub struct MsgClient < T > { inner : tonic :: client :: Grpc < T > , } impl MsgClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > MsgClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetConfirm > ,) -> Result < tonic :: Response < super :: MsgValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToEth > ,) -> Result < tonic :: Response < super :: MsgSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn request_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgRequestBatch > ,) -> Result < tonic :: Response < super :: MsgRequestBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RequestBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmBatch > ,) -> Result < tonic :: Response < super :: MsgConfirmBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_logic_call (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmLogicCall > ,) -> Result < tonic :: Response < super :: MsgConfirmLogicCallResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmLogicCall") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposit_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgDepositClaim > ,) -> Result < tonic :: Response < super :: MsgDepositClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/DepositClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn withdraw_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgWithdrawClaim > ,) -> Result < tonic :: Response < super :: MsgWithdrawClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/WithdrawClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_update_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetUpdatedClaim > ,) -> Result < tonic :: Response < super :: MsgValsetUpdatedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetUpdateClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_deployed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgErc20DeployedClaim > ,) -> Result < tonic :: Response < super :: MsgErc20DeployedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ERC20DeployedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_call_executed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgLogicCallExecutedClaim > ,) -> Result < tonic :: Response < super :: MsgLogicCallExecutedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/LogicCallExecutedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgSetOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cancel_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgCancelSendToEth > ,) -> Result < tonic :: Response < super :: MsgCancelSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/CancelSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for MsgClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for MsgClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "MsgClient {{ ... }}") } } }/// Params represent the Gravity genesis and store parameters
+/// MsgIncreaseBridgeFee
+/// This call allows the sender (and only the sender) of a MsgSendToEth to add
+/// to its bridge fee while it is not in a batch yet, so that it is picked up
+/// sooner without canceling and resubmitting it
+/// -------------
+/// ADDITIONAL_FEE:
+/// the amount added to the bridge fee, in the denom of the transfer. It is
+/// taken from the sender like the fee of a MsgSendToEth
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgIncreaseBridgeFee {
+    #[prost(uint64, tag="1")]
+    pub transaction_id: u64,
+    #[prost(string, tag="2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub additional_fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgIncreaseBridgeFeeResponse {
+}
+/// This call allows anyone to submit evidence that a
+/// validator has signed a valset, batch, or logic call that never
+/// existed. Subject contains the batch, valset, or logic call.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitBadSignatureEvidence {
+    #[prost(message, optional, tag="1")]
+    pub subject: ::core::option::Option<::prost_types::Any>,
+    #[prost(string, tag="2")]
+    pub signature: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitBadSignatureEvidenceResponse {
+}
+/// MsgRotateEthKey
+/// this message allows a validator to replace the Ethereum key it signs valsets,
+/// batches and logic calls with. Until a valset that includes the new key is
+/// observed on Ethereum the previous key stays valid for confirms, since the
+/// Gravity.sol contract only knows the previous key until then
+/// VALIDATOR
+/// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+/// of the validator rotating its key, the validator's operator account signs
+/// the message
+/// NEW_ETH_ADDRESS
+/// The hex encoded 0x Ethereum address of the new key
+/// SIGNATURE
+/// A hex encoded signature by the new key over the gravity id and the validator
+/// address, proving that the validator holds the new key
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRotateEthKey {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub new_eth_address: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub signature: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRotateEthKeyResponse {
+}
+# [doc = r" Generated client implementations."] pub mod msg_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Msg defines the state transitions possible within gravity"] pub struct MsgClient < T > { inner : tonic :: client :: Grpc < T > , } impl MsgClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > MsgClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetConfirm > ,) -> Result < tonic :: Response < super :: MsgValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToEth > ,) -> Result < tonic :: Response < super :: MsgSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn request_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgRequestBatch > ,) -> Result < tonic :: Response < super :: MsgRequestBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RequestBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmBatch > ,) -> Result < tonic :: Response < super :: MsgConfirmBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_logic_call (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmLogicCall > ,) -> Result < tonic :: Response < super :: MsgConfirmLogicCallResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmLogicCall") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposit_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgDepositClaim > ,) -> Result < tonic :: Response < super :: MsgDepositClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/DepositClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn withdraw_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgWithdrawClaim > ,) -> Result < tonic :: Response < super :: MsgWithdrawClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/WithdrawClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_update_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetUpdatedClaim > ,) -> Result < tonic :: Response < super :: MsgValsetUpdatedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetUpdateClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_deployed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgErc20DeployedClaim > ,) -> Result < tonic :: Response < super :: MsgErc20DeployedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ERC20DeployedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_call_executed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgLogicCallExecutedClaim > ,) -> Result < tonic :: Response < super :: MsgLogicCallExecutedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/LogicCallExecutedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgSetOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cancel_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgCancelSendToEth > ,) -> Result < tonic :: Response < super :: MsgCancelSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/CancelSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn increase_bridge_fee (& mut self , request : impl tonic :: IntoRequest < super :: MsgIncreaseBridgeFee > ,) -> Result < tonic :: Response < super :: MsgIncreaseBridgeFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/IncreaseBridgeFee") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_bad_signature_evidence (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitBadSignatureEvidence > ,) -> Result < tonic :: Response < super :: MsgSubmitBadSignatureEvidenceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitBadSignatureEvidence") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn rotate_eth_key (& mut self , request : impl tonic :: IntoRequest < super :: MsgRotateEthKey > ,) -> Result < tonic :: Response < super :: MsgRotateEthKeyResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RotateEthKey") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn add_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgAddOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgAddOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/AddOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn remove_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgRemoveOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgRemoveOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RemoveOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for MsgClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for MsgClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "MsgClient {{ ... }}") } } }/// IDSet represents a set of IDs
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IdSet {
+    #[prost(uint64, repeated, tag="1")]
+    pub ids: ::prost::alloc::vec::Vec<u64>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchFees {
+    #[prost(string, tag="1")]
+    pub token: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub total_fees: ::prost::alloc::string::String,
+    /// the number of transactions the next batch of the token would have
+    #[prost(uint64, tag="3")]
+    pub tx_count: u64,
+    /// whether the next batch of the token would meet its batch threshold
+    #[prost(bool, tag="4")]
+    pub meets_threshold: bool,
+}
+/// BatchThreshold is the minimum total fee and number of transactions of a batch
+/// of a token. A threshold without a token contract applies to every token that
+/// has no threshold of its own. Zero values are not enforced
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchThreshold {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub min_batch_fee: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub min_batch_size: u64,
+}
+/// TokenBatchSize is the maximum number of transactions in a batch of a token
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TokenBatchSize {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub batch_size: u64,
+}
+/// AutoBatchTrigger makes the EndBlocker build a batch of a token once the next
+/// batch would have MIN_FEES, or once the oldest transfer in the pool has waited
+/// more than MAX_AGE blocks. A trigger without a token contract applies to every
+/// token that has no trigger of its own. Zero values never trigger
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AutoBatchTrigger {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub min_fees: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub max_age: u64,
+}
+/// OutboundRateLimit limits the amount of a token that can be sent to Ethereum,
+/// amounts include the bridge fee. A limit without a token contract applies to
+/// every token that has no limit of its own. Zero values are not limited
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct OutboundRateLimit {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    /// the maximum total amount sent within the outbound rate limit window
+    #[prost(string, tag="2")]
+    pub window_limit: ::prost::alloc::string::String,
+    /// the maximum amount of a single transfer
+    #[prost(string, tag="3")]
+    pub max_transfer: ::prost::alloc::string::String,
+}
+/// InboundRateLimit limits the amount of a token deposited from Ethereum that is
+/// credited within the inbound rate limit window. A limit without a token
+/// contract applies to every token that has no limit of its own. A zero window
+/// limit is not limited
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct InboundRateLimit {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    /// the maximum total amount credited within the inbound rate limit window
+    #[prost(string, tag="2")]
+    pub window_limit: ::prost::alloc::string::String,
+}
+/// QueuedDeposit is a deposit that has been observed but not credited yet,
+/// either because inbound deposits are paused or because crediting it would
+/// exceed the inbound rate limit of its token. A deposit above the window limit
+/// is credited in parts, its amount is what is left to credit
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueuedDeposit {
+    #[prost(message, optional, tag="1")]
+    pub deposit: ::core::option::Option<MsgDepositClaim>,
+    /// the Cosmos block height the deposit was queued at
+    #[prost(uint64, tag="2")]
+    pub queued_height: u64,
+}
+/// Params represent the Gravity genesis and store parameters
 /// gravity_id:
 /// a random 32 byte value to prevent signature reuse, for example if the
 /// cosmos validators decided to use the same Ethereum keys for another chain
@@ -452,9 +699,10 @@ pub struct MsgCancelSendToEthResponse {
 /// signed_valsets_window
 /// signed_batches_window
 /// signed_claims_window
+/// signed_logic_calls_window
 ///
 /// These values represent the time in blocks that a validator has to submit
-/// a signature for a batch or valset, or to submit a claim for a particular
+/// a signature for a batch, valset or logic call, or to submit a claim for a particular
 /// attestation nonce. In the case of attestations this clock starts when the
 /// attestation is created, but only allows for slashing once the event has passed
 ///
@@ -476,10 +724,116 @@ pub struct MsgCancelSendToEthResponse {
 /// slash_fraction_batch
 /// slash_fraction_claim
 /// slash_fraction_conflicting_claim
+/// slash_fraction_logic_call
 ///
 /// The slashing fractions for the various gravity related slashing conditions. The first three
-/// refer to not submitting a particular message, the third for submitting a different claim
-/// for the same Ethereum event
+/// refer to not submitting a particular message, the fourth for submitting a different claim
+/// for the same Ethereum event and the last for not signing an outgoing logic call
+///
+/// attestation_votes_power_threshold
+///
+/// The fraction of the total voting power that has to vote for an attestation before
+/// it is observed and applied to the Cosmos state, must be above 0.5 and at most 1
+///
+/// bridge_halted
+///
+/// Set by the Gravity module when it detects that the bridge has been hijacked, no
+/// outgoing batches or logic calls are created while it is set. Only governance
+/// can clear it once the incident has been dealt with
+///
+/// inbound_deposits_paused
+///
+/// Set by governance to pause deposits from Ethereum, deposits observed while paused
+/// are queued and credited once deposits are resumed
+///
+/// outbound_sends_paused
+///
+/// Set by governance to pause sending tokens to Ethereum, no new transfers are added
+/// to the outgoing pool while it is set. Pending transfers can still be cancelled
+///
+/// batch_creation_paused
+///
+/// Set by governance to pause the creation of new outgoing batches
+///
+/// outbound_rate_limit_window
+///
+/// The number of Cosmos blocks over which the amount sent to Ethereum is limited
+/// by outbound_rate_limits
+///
+/// outbound_rate_limits
+///
+/// Per token limits on the amount sent to Ethereum within the outbound rate limit
+/// window and on the size of a single transfer
+///
+/// outbound_global_window_limit
+///
+/// The maximum total amount of all tokens together sent to Ethereum within the
+/// outbound rate limit window, 0 disables it. Unlike the outbound_rate_limits
+/// entry without a token contract, which limits every token on its own, it caps
+/// the sum over all tokens
+///
+/// inbound_rate_limit_window
+///
+/// The number of Cosmos blocks over which the amount deposited from Ethereum is
+/// limited by inbound_rate_limits
+///
+/// inbound_rate_limits
+///
+/// Per token limits on the amount deposited from Ethereum that is credited within
+/// the inbound rate limit window, deposits above the limit are queued and credited
+/// once the window allows it
+///
+/// valset_power_change_threshold
+///
+/// The fraction of the normalized bridge power that has to change compared to the
+/// latest valset before a new valset is created, must be between 0 and 1
+///
+/// valset_max_age
+///
+/// The number of Cosmos blocks after which a new valset is created even if the
+/// validator set did not change, 0 disables this trigger
+///
+/// valset_min_spacing
+///
+/// The minimum number of Cosmos blocks between two valsets, any trigger that fires
+/// earlier waits until the spacing has passed. Must be below the unbond slashing
+/// valsets window so that unbonding validators can still sign a valset without them
+///
+/// observed_valsets_window
+///
+/// The number of Cosmos blocks for which an observed valset is kept in the
+/// archive after it was replaced on Ethereum, must be positive
+///
+/// max_orchestrators_per_validator
+///
+/// The maximum number of orchestrator keys a validator can delegate to, any of
+/// them can submit claims and confirms on behalf of the validator. Lowering it
+/// leaves the keys in place but no more can be added until a validator is below it
+///
+/// batch_thresholds
+///
+/// Per token minimum total fee and number of transactions of a batch, a batch
+/// below them is not created since relayers would not pick it up
+///
+/// batch_size
+///
+/// The maximum number of transactions in a batch of a token without a batch
+/// size of its own, 0 uses the default of 100
+///
+/// token_batch_sizes
+///
+/// Per token maximum number of transactions in a batch, for tokens that are
+/// more expensive to transfer on Ethereum
+///
+/// auto_batch_triggers
+///
+/// Per token conditions under which the EndBlocker builds a batch without a
+/// MsgRequestBatch, no batches are built automatically if empty
+///
+/// eth_key_rotation_timeout
+///
+/// The number of Cosmos blocks after which an Ethereum key rotation is completed
+/// even if no valset including the new key was observed, must be positive
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Params {
     #[prost(string, tag="1")]
@@ -512,6 +866,52 @@ pub struct Params {
     pub slash_fraction_conflicting_claim: ::prost::alloc::vec::Vec<u8>,
     #[prost(uint64, tag="17")]
     pub unbond_slashing_valsets_window: u64,
+    #[prost(bytes="vec", tag="18")]
+    pub slash_fraction_bad_eth_signature: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag="19")]
+    pub signed_logic_calls_window: u64,
+    #[prost(bytes="vec", tag="20")]
+    pub slash_fraction_logic_call: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes="vec", tag="21")]
+    pub attestation_votes_power_threshold: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bool, tag="22")]
+    pub bridge_halted: bool,
+    #[prost(bool, tag="23")]
+    pub inbound_deposits_paused: bool,
+    #[prost(bool, tag="24")]
+    pub outbound_sends_paused: bool,
+    #[prost(bool, tag="25")]
+    pub batch_creation_paused: bool,
+    #[prost(uint64, tag="26")]
+    pub outbound_rate_limit_window: u64,
+    #[prost(message, repeated, tag="27")]
+    pub outbound_rate_limits: ::prost::alloc::vec::Vec<OutboundRateLimit>,
+    #[prost(uint64, tag="28")]
+    pub inbound_rate_limit_window: u64,
+    #[prost(message, repeated, tag="29")]
+    pub inbound_rate_limits: ::prost::alloc::vec::Vec<InboundRateLimit>,
+    #[prost(bytes="vec", tag="30")]
+    pub valset_power_change_threshold: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag="31")]
+    pub valset_max_age: u64,
+    #[prost(uint64, tag="32")]
+    pub valset_min_spacing: u64,
+    #[prost(uint64, tag="33")]
+    pub observed_valsets_window: u64,
+    #[prost(uint64, tag="34")]
+    pub max_orchestrators_per_validator: u64,
+    #[prost(message, repeated, tag="35")]
+    pub batch_thresholds: ::prost::alloc::vec::Vec<BatchThreshold>,
+    #[prost(uint64, tag="36")]
+    pub batch_size: u64,
+    #[prost(message, repeated, tag="37")]
+    pub token_batch_sizes: ::prost::alloc::vec::Vec<TokenBatchSize>,
+    #[prost(message, repeated, tag="38")]
+    pub auto_batch_triggers: ::prost::alloc::vec::Vec<AutoBatchTrigger>,
+    #[prost(uint64, tag="39")]
+    pub eth_key_rotation_timeout: u64,
+    #[prost(string, tag="40")]
+    pub outbound_global_window_limit: ::prost::alloc::string::String,
 }
 /// GenesisState struct
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -540,6 +940,17 @@ pub struct GenesisState {
     pub erc20_to_denoms: ::prost::alloc::vec::Vec<Erc20ToDenom>,
     #[prost(message, repeated, tag="12")]
     pub unbatched_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, repeated, tag="13")]
+    pub bridge_hijack_incidents: ::prost::alloc::vec::Vec<BridgeHijackIncident>,
+    #[prost(message, repeated, tag="14")]
+    pub queued_deposits: ::prost::alloc::vec::Vec<QueuedDeposit>,
+    #[prost(message, repeated, tag="15")]
+    pub observed_valsets: ::prost::alloc::vec::Vec<ObservedValset>,
+    #[prost(message, repeated, tag="16")]
+    pub eth_key_rotations: ::prost::alloc::vec::Vec<EthKeyRotation>,
+    /// attestations up to this event nonce were slashed and pruned
+    #[prost(uint64, tag="17")]
+    pub last_slashed_attestation_nonce: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryParamsRequest {
@@ -769,4 +1180,247 @@ pub struct QueryPendingSendToEthResponse {
     #[prost(message, repeated, tag="2")]
     pub unbatched_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
 }
-# [doc = r" Generated client implementations."] pub mod query_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Query defines the gRPC querier service"] pub struct QueryClient < T > { inner : tonic :: client :: Grpc < T > , } impl QueryClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > QueryClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } # [doc = " Deployments queries deployments"] pub async fn params (& mut self , request : impl tonic :: IntoRequest < super :: QueryParamsRequest > ,) -> Result < tonic :: Response < super :: QueryParamsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/Params") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn current_valset (& mut self , request : impl tonic :: IntoRequest < super :: QueryCurrentValsetRequest > ,) -> Result < tonic :: Response < super :: QueryCurrentValsetResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/CurrentValset") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_request (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetRequestRequest > ,) -> Result < tonic :: Response < super :: QueryValsetRequestResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetRequest") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirms_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmsByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmsByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirmsByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_valset_requests (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastValsetRequestsRequest > ,) -> Result < tonic :: Response < super :: QueryLastValsetRequestsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastValsetRequests") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_valset_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingValsetRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingValsetRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingValsetRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_batch_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingBatchRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingBatchRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingBatchRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_logic_call_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingLogicCallByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingLogicCallByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingLogicCallByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_event_nonce_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastEventNonceByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastEventNonceByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastEventNonceByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_fees (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchFeeRequest > ,) -> Result < tonic :: Response < super :: QueryBatchFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchFees") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_tx_batches (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingTxBatchesRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingTxBatchesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingTxBatches") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_logic_calls (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingLogicCallsRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingLogicCallsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingLogicCalls") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_request_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchRequestByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryBatchRequestByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchRequestByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryBatchConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryLogicConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryLogicConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LogicConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_to_denom (& mut self , request : impl tonic :: IntoRequest < super :: QueryErc20ToDenomRequest > ,) -> Result < tonic :: Response < super :: QueryErc20ToDenomResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ERC20ToDenom") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn denom_to_erc20 (& mut self , request : impl tonic :: IntoRequest < super :: QueryDenomToErc20Request > ,) -> Result < tonic :: Response < super :: QueryDenomToErc20Response > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DenomToERC20") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByValidatorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByValidatorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByEthAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByEthAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_orchestrator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByOrchestratorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByOrchestrator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_pending_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingSendToEth > ,) -> Result < tonic :: Response < super :: QueryPendingSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetPendingSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for QueryClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for QueryClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "QueryClient {{ ... }}") } } }
\ No newline at end of file
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeHijackIncidentsRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeHijackIncidentsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub incidents: ::prost::alloc::vec::Vec<BridgeHijackIncident>,
+    #[prost(bool, tag="2")]
+    pub bridge_halted: bool,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeStatusRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeStatusResponse {
+    #[prost(bool, tag="1")]
+    pub bridge_halted: bool,
+    #[prost(bool, tag="2")]
+    pub inbound_deposits_paused: bool,
+    #[prost(bool, tag="3")]
+    pub outbound_sends_paused: bool,
+    #[prost(bool, tag="4")]
+    pub batch_creation_paused: bool,
+    #[prost(message, repeated, tag="5")]
+    pub queued_deposits: ::prost::alloc::vec::Vec<QueuedDeposit>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOutboundRateLimitUsageRequest {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOutboundRateLimitUsageResponse {
+    /// the amount sent within the current window
+    #[prost(string, tag="1")]
+    pub window_usage: ::prost::alloc::string::String,
+    /// the number of blocks in the window
+    #[prost(uint64, tag="2")]
+    pub window: u64,
+    /// the limit that applies to the token, if any
+    #[prost(message, optional, tag="3")]
+    pub limit: ::core::option::Option<OutboundRateLimit>,
+    /// the amount of all tokens together sent within the current window
+    #[prost(string, tag="4")]
+    pub global_window_usage: ::prost::alloc::string::String,
+    /// the limit on the amount of all tokens together, 0 if disabled
+    #[prost(string, tag="5")]
+    pub global_window_limit: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryQueuedDepositsByReceiverRequest {
+    #[prost(string, tag="1")]
+    pub receiver: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryQueuedDepositsByReceiverResponse {
+    #[prost(message, repeated, tag="1")]
+    pub deposits: ::prost::alloc::vec::Vec<QueuedDeposit>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValidatorsMissingEthKeysRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValidatorsMissingEthKeysResponse {
+    /// the operator addresses of bonded validators that are left out of the valset
+    #[prost(string, repeated, tag="1")]
+    pub validators: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// RelaySignature is the signature of one member of the valset on Ethereum, split
+/// the way the Gravity.sol contract takes it. v is 0 and r and s are empty if the
+/// member has not signed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RelaySignature {
+    #[prost(string, tag="1")]
+    pub ethereum_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub power: u64,
+    #[prost(uint32, tag="3")]
+    pub v: u32,
+    #[prost(string, tag="4")]
+    pub r: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub s: ::prost::alloc::string::String,
+}
+/// RelaySignatures are the signatures needed to submit a valset update, batch or
+/// logic call to the Gravity.sol contract. The signatures are ordered like the
+/// members of the valset the contract currently holds, which is the last valset
+/// observed on Ethereum
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RelaySignatures {
+    #[prost(message, optional, tag="1")]
+    pub current_valset: ::core::option::Option<Valset>,
+    #[prost(message, repeated, tag="2")]
+    pub signatures: ::prost::alloc::vec::Vec<RelaySignature>,
+    /// the power of the members that have signed
+    #[prost(uint64, tag="3")]
+    pub signed_power: u64,
+    /// whether the signed power is above the power threshold of the contract
+    #[prost(bool, tag="4")]
+    pub threshold_met: bool,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetSignaturesRequest {
+    #[prost(uint64, tag="1")]
+    pub nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetSignaturesResponse {
+    #[prost(message, optional, tag="1")]
+    pub valset: ::core::option::Option<Valset>,
+    #[prost(message, optional, tag="2")]
+    pub signatures: ::core::option::Option<RelaySignatures>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBatchSignaturesRequest {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBatchSignaturesResponse {
+    #[prost(message, optional, tag="1")]
+    pub batch: ::core::option::Option<OutgoingTxBatch>,
+    #[prost(message, optional, tag="2")]
+    pub signatures: ::core::option::Option<RelaySignatures>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryLogicCallSignaturesRequest {
+    #[prost(bytes="vec", tag="1")]
+    pub invalidation_id: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag="2")]
+    pub invalidation_nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryLogicCallSignaturesResponse {
+    #[prost(message, optional, tag="1")]
+    pub logic_call: ::core::option::Option<OutgoingLogicCall>,
+    #[prost(message, optional, tag="2")]
+    pub signatures: ::core::option::Option<RelaySignatures>,
+}
+/// QueryValsetAtEthereumHeightRequest asks for the valset that was active on the
+/// Gravity.sol contract at an Ethereum block height
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetAtEthereumHeightRequest {
+    #[prost(uint64, tag="1")]
+    pub height: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetAtEthereumHeightResponse {
+    /// empty if no archived valset was observed at or before the height
+    #[prost(message, optional, tag="1")]
+    pub observed_valset: ::core::option::Option<ObservedValset>,
+}
+/// QueryValsetAtCosmosHeightRequest asks for the valset that this chain had
+/// observed as active on the Gravity.sol contract at a Cosmos block height
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetAtCosmosHeightRequest {
+    #[prost(uint64, tag="1")]
+    pub height: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetAtCosmosHeightResponse {
+    /// empty if no archived valset was observed at or before the height
+    #[prost(message, optional, tag="1")]
+    pub observed_valset: ::core::option::Option<ObservedValset>,
+}
+/// QueryDelegateKeysNonceRequest asks for the nonce the next
+/// MsgSetOrchestratorAddress of a validator has to be signed with
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDelegateKeysNonceRequest {
+    #[prost(string, tag="1")]
+    pub validator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDelegateKeysNonceResponse {
+    #[prost(uint64, tag="1")]
+    pub nonce: u64,
+}
+/// QueryOrchestratorsByValidatorRequest asks for all the orchestrator keys a
+/// validator has delegated to
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOrchestratorsByValidatorRequest {
+    #[prost(string, tag="1")]
+    pub validator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOrchestratorsByValidatorResponse {
+    #[prost(string, repeated, tag="1")]
+    pub orchestrator_addresses: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// PendingTransfer is a transfer that has not been executed on Ethereum yet,
+/// in_batch is set once the transfer is in a batch and can't be canceled
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PendingTransfer {
+    #[prost(message, optional, tag="1")]
+    pub transfer: ::core::option::Option<OutgoingTransferTx>,
+    #[prost(bool, tag="2")]
+    pub in_batch: bool,
+}
+/// QueryPendingTransfersBySenderRequest asks for the transfers of a sender
+/// that have not been executed on Ethereum yet, ordered by id
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingTransfersBySenderRequest {
+    #[prost(string, tag="1")]
+    pub sender_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingTransfersBySenderResponse {
+    #[prost(message, repeated, tag="1")]
+    pub transfers: ::prost::alloc::vec::Vec<PendingTransfer>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// QueryPendingTransfersByReceiverRequest asks for the transfers to an
+/// Ethereum address that have not been executed on Ethereum yet, ordered by id
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingTransfersByReceiverRequest {
+    #[prost(string, tag="1")]
+    pub dest_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingTransfersByReceiverResponse {
+    #[prost(message, repeated, tag="1")]
+    pub transfers: ::prost::alloc::vec::Vec<PendingTransfer>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+# [doc = r" Generated client implementations."] pub mod query_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Query defines the gRPC querier service"] pub struct QueryClient < T > { inner : tonic :: client :: Grpc < T > , } impl QueryClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > QueryClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } # [doc = " Deployments queries deployments"] pub async fn params (& mut self , request : impl tonic :: IntoRequest < super :: QueryParamsRequest > ,) -> Result < tonic :: Response < super :: QueryParamsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/Params") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn current_valset (& mut self , request : impl tonic :: IntoRequest < super :: QueryCurrentValsetRequest > ,) -> Result < tonic :: Response < super :: QueryCurrentValsetResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/CurrentValset") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_request (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetRequestRequest > ,) -> Result < tonic :: Response < super :: QueryValsetRequestResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetRequest") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirms_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmsByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmsByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirmsByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_valset_requests (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastValsetRequestsRequest > ,) -> Result < tonic :: Response < super :: QueryLastValsetRequestsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastValsetRequests") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_valset_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingValsetRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingValsetRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingValsetRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_batch_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingBatchRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingBatchRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingBatchRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_logic_call_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingLogicCallByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingLogicCallByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingLogicCallByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_event_nonce_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastEventNonceByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastEventNonceByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastEventNonceByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_fees (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchFeeRequest > ,) -> Result < tonic :: Response < super :: QueryBatchFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchFees") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_tx_batches (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingTxBatchesRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingTxBatchesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingTxBatches") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_logic_calls (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingLogicCallsRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingLogicCallsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingLogicCalls") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_request_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchRequestByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryBatchRequestByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchRequestByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryBatchConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryLogicConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryLogicConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LogicConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_to_denom (& mut self , request : impl tonic :: IntoRequest < super :: QueryErc20ToDenomRequest > ,) -> Result < tonic :: Response < super :: QueryErc20ToDenomResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ERC20ToDenom") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn denom_to_erc20 (& mut self , request : impl tonic :: IntoRequest < super :: QueryDenomToErc20Request > ,) -> Result < tonic :: Response < super :: QueryDenomToErc20Response > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DenomToERC20") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByValidatorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByValidatorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByEthAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByEthAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_orchestrator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByOrchestratorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByOrchestrator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_pending_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingSendToEth > ,) -> Result < tonic :: Response < super :: QueryPendingSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetPendingSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_hijack_incidents (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeHijackIncidentsRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeHijackIncidentsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeHijackIncidents") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_status (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeStatusRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeStatusResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeStatus") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outbound_rate_limit_usage (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutboundRateLimitUsageRequest > ,) -> Result < tonic :: Response < super :: QueryOutboundRateLimitUsageResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutboundRateLimitUsage") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn queued_deposits_by_receiver (& mut self , request : impl tonic :: IntoRequest < super :: QueryQueuedDepositsByReceiverRequest > ,) -> Result < tonic :: Response < super :: QueryQueuedDepositsByReceiverResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/QueuedDepositsByReceiver") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn validators_missing_eth_keys (& mut self , request : impl tonic :: IntoRequest < super :: QueryValidatorsMissingEthKeysRequest > ,) -> Result < tonic :: Response < super :: QueryValidatorsMissingEthKeysResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValidatorsMissingEthKeys") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_signatures (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetSignaturesRequest > ,) -> Result < tonic :: Response < super :: QueryValsetSignaturesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetSignatures") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_signatures (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchSignaturesRequest > ,) -> Result < tonic :: Response < super :: QueryBatchSignaturesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchSignatures") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_call_signatures (& mut self , request : impl tonic :: IntoRequest < super :: QueryLogicCallSignaturesRequest > ,) -> Result < tonic :: Response < super :: QueryLogicCallSignaturesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LogicCallSignatures") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_at_ethereum_height (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetAtEthereumHeightRequest > ,) -> Result < tonic :: Response < super :: QueryValsetAtEthereumHeightResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetAtEthereumHeight") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_at_cosmos_height (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetAtCosmosHeightRequest > ,) -> Result < tonic :: Response < super :: QueryValsetAtCosmosHeightResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetAtCosmosHeight") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn delegate_keys_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysNonceRequest > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DelegateKeysNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn orchestrators_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QueryOrchestratorsByValidatorRequest > ,) -> Result < tonic :: Response < super :: QueryOrchestratorsByValidatorResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OrchestratorsByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn pending_transfers_by_sender (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingTransfersBySenderRequest > ,) -> Result < tonic :: Response < super :: QueryPendingTransfersBySenderResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/PendingTransfersBySender") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn pending_transfers_by_receiver (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingTransfersByReceiverRequest > ,) -> Result < tonic :: Response < super :: QueryPendingTransfersByReceiverResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/PendingTransfersByReceiver") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for QueryClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for QueryClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "QueryClient {{ ... }}") } } }/// CancelQueuedDepositProposal is a governance proposal to cancel a deposit that
+/// is queued and has not been credited yet. The deposited tokens stay locked in
+/// the bridge contract on Ethereum
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CancelQueuedDepositProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub event_nonce: u64,
+}
@@ -314,3 +314,22 @@ fn test_logic_call_signature() {
     assert_eq!(correct_hash.len(), checkpoint_hash.len());
     assert_eq!(correct_hash, checkpoint_hash.as_slice())
 }
+
+/// takes the required input data and produces the message the delegate Ethereum key signs
+/// to set the delegate keys of a validator, matching GetDelegateKeysHash on the Cosmos side.
+/// The gravity id keeps the signature from being replayed on another chain and the delegate
+/// keys nonce of the validator from being replayed on this one
+/// Note: This is the message, you need to run Keccak256::digest() in order to get the 32byte
+/// digest that is normally signed or may be used as a 'hash of the message'
+pub fn encode_delegate_keys(
+    gravity_id: String,
+    validator: &[u8],
+    orchestrator: &[u8],
+    nonce: u64,
+) -> Vec<u8> {
+    let mut message = gravity_id.into_bytes();
+    message.extend_from_slice(validator);
+    message.extend_from_slice(orchestrator);
+    message.extend_from_slice(&nonce.to_be_bytes());
+    message
+}
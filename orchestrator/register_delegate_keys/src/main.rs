@@ -108,12 +108,11 @@ async fn main() {
         key
     };
 
-    let ethereum_address = ethereum_key.to_public_key().unwrap();
     let cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
     update_gravity_delegate_addresses(
         &contact,
-        ethereum_address,
-        cosmos_address,
+        ethereum_key,
+        cosmos_key,
         validator_key,
         fee.clone(),
     )
@@ -76,11 +76,11 @@ pub async fn orch_keys_update(
             eth_key.to_public_key().unwrap(),
             cosmos_address,
         );
-        // send in the new delegate keys signed by the validator address
+        // send in the new delegate keys signed by the validator and the new orchestrator address
         updates.push(update_gravity_delegate_addresses(
             &contact,
-            eth_key.to_public_key().unwrap(),
-            cosmos_address,
+            eth_key,
+            cosmos_key,
             k.validator_key,
             get_fee(),
         ));
@@ -101,10 +101,10 @@ $gravity $home0 add-genesis-account $($gravity $home3 keys show val -a $kbt) $co
 #$gravity $home0 add-genesis-account $($FED $home3 keys show feeder) $coins &>/dev/null
 
 echo "Generating orchestrator keys"
-$gravity $home0 keys add --dry-run=true --output=json orch | jq . >> $n0dir/orchestrator_key.json
-$gravity $home1 keys add --dry-run=true --output=json orch | jq . >> $n1dir/orchestrator_key.json
-$gravity $home2 keys add --dry-run=true --output=json orch | jq . >> $n2dir/orchestrator_key.json
-$gravity $home3 keys add --dry-run=true --output=json orch | jq . >> $n3dir/orchestrator_key.json
+$gravity $home0 keys add $kbt --output=json orch | jq . >> $n0dir/orchestrator_key.json
+$gravity $home1 keys add $kbt --output=json orch | jq . >> $n1dir/orchestrator_key.json
+$gravity $home2 keys add $kbt --output=json orch | jq . >> $n2dir/orchestrator_key.json
+$gravity $home3 keys add $kbt --output=json orch | jq . >> $n3dir/orchestrator_key.json
 
 echo "Adding orchestrator keys to genesis"
 n0orchKey="$(jq .address $n0dir/orchestrator_key.json)"
@@ -141,10 +141,14 @@ jq ".alloc |= . + {$(jq .address $n2dir/eth_key.json) : {\"balance\": \"0x133700
 jq ".alloc |= . + {$(jq .address $n3dir/eth_key.json) : {\"balance\": \"0x1337000000000000000000\"}}" $home_dir/ETHGenesis.json | sponge $home_dir/ETHGenesis.json
 
 echo "Creating gentxs"
-$gravity $home0 gentx --ip $n0name val 100000000000stake $(jq -r .address $n0dir/eth_key.json) $(jq -r .address $n0dir/orchestrator_key.json) $kbt $cid &>/dev/null
-$gravity $home1 gentx --ip $n1name val 100000000000stake $(jq -r .address $n1dir/eth_key.json) $(jq -r .address $n1dir/orchestrator_key.json) $kbt $cid &>/dev/null
-$gravity $home2 gentx --ip $n2name val 100000000000stake $(jq -r .address $n2dir/eth_key.json) $(jq -r .address $n2dir/orchestrator_key.json) $kbt $cid &>/dev/null
-$gravity $home3 gentx --ip $n3name val 100000000000stake $(jq -r .address $n3dir/eth_key.json) $(jq -r .address $n3dir/orchestrator_key.json) $kbt $cid &>/dev/null
+jq -r .private_key $n0dir/eth_key.json > $n0dir/eth_private_key
+$gravity $home0 gentx --ip $n0name --eth-key-file $n0dir/eth_private_key val 100000000000stake orch $kbt $cid &>/dev/null
+jq -r .private_key $n1dir/eth_key.json > $n1dir/eth_private_key
+$gravity $home1 gentx --ip $n1name --eth-key-file $n1dir/eth_private_key val 100000000000stake orch $kbt $cid &>/dev/null
+jq -r .private_key $n2dir/eth_key.json > $n2dir/eth_private_key
+$gravity $home2 gentx --ip $n2name --eth-key-file $n2dir/eth_private_key val 100000000000stake orch $kbt $cid &>/dev/null
+jq -r .private_key $n3dir/eth_key.json > $n3dir/eth_private_key
+$gravity $home3 gentx --ip $n3name --eth-key-file $n3dir/eth_private_key val 100000000000stake orch $kbt $cid &>/dev/null
 
 echo "Collecting gentxs in $n0name"
 cp $n1cfgDir/gentx/*.json $n0cfgDir/gentx/